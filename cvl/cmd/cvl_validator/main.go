@@ -0,0 +1,348 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Command cvl_validator validates CONFIG_DB data offline, against the
+// sonic YANG schema, without a running redis.
+//
+// It validates either a complete config_db.json file, or an edit sequence
+// against a base config_db.json file. Errors are reported in text or JSON
+// format, and the exit status is 1 if the data is invalid, or 2 if the
+// validation could not be done.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/Azure/sonic-mgmt-common/cvl"
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitFailure = 2
+)
+
+var (
+	schemaDir = flag.String("schema", "", "Directory of the sonic YANG schema (.yin) files. Overrides CVL_SCHEMA_PATH")
+	baseFile  = flag.String("base", "", "Base config_db.json file, for validating an edit sequence")
+	editsFile = flag.String("edits", "", "Edit sequence file to validate against the base config")
+	format    = flag.String("format", "text", "Output format, text or json")
+	maxErrors = flag.Int("max-errors", 100, "Maximum number of errors reported for an edit sequence")
+	debug     = flag.Bool("debug", false, "Enable libyang debug logs")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage:
+  %[1]s [options] CONFIG_DB_JSON
+  %[1]s [options] [-base CONFIG_DB_JSON] -edits EDITS_JSON
+
+The edit sequence is a JSON array of operations on CONFIG_DB entries, e.g.
+  [{"op": "create", "key": "VLAN|Vlan10", "data": {"vlanid": "10"}},
+   {"op": "delete", "key": "VLAN_MEMBER|Vlan20|Ethernet0"}]
+Supported operations are create, update and delete. Fields of a delete
+operation, if any, are deleted instead of the entry.
+
+Options:
+`, filepath.Base(os.Args[0]))
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *format != "text" && *format != "json" {
+		fail(fmt.Errorf("unknown format %q", *format))
+	}
+	if (len(*editsFile) == 0) == (flag.NArg() == 0) || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(exitFailure)
+	}
+
+	if len(*schemaDir) != 0 {
+		if err := setSchemaPath(*schemaDir); err != nil {
+			fail(err)
+		}
+	}
+	if err := checkSchemaPath(); err != nil {
+		fail(err)
+	}
+
+	cvl.Debug(*debug)
+
+	var errs []cvl.CVLErrorInfo
+	var err error
+	if len(*editsFile) != 0 {
+		errs, err = validateEdits(*baseFile, *editsFile)
+	} else {
+		errs, err = validateConfig(flag.Arg(0))
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	if *format == "json" {
+		err = printJSON(os.Stdout, errs)
+	} else {
+		err = printText(os.Stdout, errs)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	if len(errs) != 0 {
+		os.Exit(exitInvalid)
+	}
+	os.Exit(exitValid)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+	os.Exit(exitFailure)
+}
+
+// setSchemaPath makes cvl load the schema from dir. The schema is loaded
+// by the cvl package initialization, from the CVL_SCHEMA_PATH directory;
+// hence the command is run again with CVL_SCHEMA_PATH set, unless it was
+// already set to dir.
+func setSchemaPath(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	if os.Getenv("CVL_SCHEMA_PATH") == dir {
+		return nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	os.Setenv("CVL_SCHEMA_PATH", dir)
+	return syscall.Exec(exe, os.Args, os.Environ())
+}
+
+// checkSchemaPath verifies that the schema directory used by cvl has schema
+// files. Data of tables without schema is not validated, hence an incorrect
+// schema directory would let any data through.
+func checkSchemaPath() error {
+	dir := os.Getenv("CVL_SCHEMA_PATH")
+	if len(dir) == 0 {
+		dir = "schema"
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.yin"))
+	if err == nil && len(files) == 0 {
+		err = fmt.Errorf("no schema files found in %s; use -schema option", dir)
+	}
+	return err
+}
+
+// validateConfig validates a complete config, ignoring the data in redis.
+func validateConfig(configFile string) ([]cvl.CVLErrorInfo, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, ret := cvl.ValidationSessOpen(nil)
+	if ret != cvl.CVL_SUCCESS {
+		return nil, fmt.Errorf("could not open validation session: %s", cvl.GetErrorString(ret))
+	}
+	defer cvl.ValidationSessClose(cv)
+
+	errs, _ := cv.ValidateStartupConfig(string(data))
+	return errs, nil
+}
+
+// validateEdits validates the edit sequence in editsFile, as if it was
+// applied on the config in baseFile. An empty config is used as base
+// config if baseFile is not given.
+func validateEdits(baseFile, editsFile string) ([]cvl.CVLErrorInfo, error) {
+	db, err := loadConfigDB(baseFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgData, err := loadEdits(editsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, ret := cvl.ValidationSessOpen(db)
+	if ret != cvl.CVL_SUCCESS {
+		return nil, fmt.Errorf("could not open validation session: %s", cvl.GetErrorString(ret))
+	}
+	defer cvl.ValidationSessClose(cv)
+
+	cv.SetMaxErrors(*maxErrors)
+	errs, _ := cv.ValidateEditConfigAll(cfgData)
+	return errs, nil
+}
+
+// editOp is an operation in the edit sequence file.
+type editOp struct {
+	Op   string                 `json:"op"`
+	Key  string                 `json:"key"`
+	Data map[string]interface{} `json:"data"`
+}
+
+var editOps = map[string]cmn.CVLOperation{
+	"create": cmn.OP_CREATE,
+	"update": cmn.OP_UPDATE,
+	"delete": cmn.OP_DELETE,
+}
+
+// loadEdits reads the edit sequence file.
+func loadEdits(fileName string) ([]cmn.CVLEditConfigData, error) {
+	var edits []editOp
+	if err := readJSONFile(fileName, &edits); err != nil {
+		return nil, err
+	}
+
+	cfgData := make([]cmn.CVLEditConfigData, 0, len(edits))
+	for i, e := range edits {
+		op, ok := editOps[strings.ToLower(e.Op)]
+		if !ok {
+			return nil, fmt.Errorf("%s: edit #%d: unknown operation %q", fileName, i+1, e.Op)
+		}
+		if len(e.Key) == 0 {
+			return nil, fmt.Errorf("%s: edit #%d: no key", fileName, i+1)
+		}
+
+		data := map[string]string{}
+		if op != cmn.OP_DELETE || len(e.Data) != 0 {
+			data = cmn.ToRedisFields(e.Data)
+		}
+
+		cfgData = append(cfgData, cmn.CVLEditConfigData{
+			VType: cmn.VALIDATE_ALL,
+			VOp:   op,
+			Key:   e.Key,
+			Data:  data,
+		})
+	}
+
+	return cfgData, nil
+}
+
+// errorEntry is the JSON format of a validation error.
+type errorEntry struct {
+	Code    int      `json:"code"`
+	Error   string   `json:"error"`
+	Table   string   `json:"table,omitempty"`
+	Keys    []string `json:"keys,omitempty"`
+	Field   string   `json:"field,omitempty"`
+	Value   string   `json:"value,omitempty"`
+	Message string   `json:"message"`
+	AppTag  string   `json:"app-tag,omitempty"`
+}
+
+func printJSON(w io.Writer, errs []cvl.CVLErrorInfo) error {
+	result := struct {
+		Valid  bool         `json:"valid"`
+		Errors []errorEntry `json:"errors"`
+	}{
+		Valid:  len(errs) == 0,
+		Errors: make([]errorEntry, 0, len(errs)),
+	}
+
+	for _, e := range errs {
+		result.Errors = append(result.Errors, errorEntry{
+			Code:    int(e.ErrCode),
+			Error:   cvl.GetErrorString(e.ErrCode),
+			Table:   e.TableName,
+			Keys:    e.Keys,
+			Field:   e.Field,
+			Value:   e.Value,
+			Message: e.Message(),
+			AppTag:  e.ErrAppTag,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func printText(w io.Writer, errs []cvl.CVLErrorInfo) error {
+	if len(errs) == 0 {
+		_, err := fmt.Fprintln(w, "Config validation succeeded")
+		return err
+	}
+
+	for _, e := range errs {
+		var sb strings.Builder
+		if len(e.TableName) != 0 {
+			sb.WriteString(strings.Join(append([]string{e.TableName}, e.Keys...), "|"))
+			sb.WriteString(": ")
+		}
+		if len(e.Field) != 0 {
+			fmt.Fprintf(&sb, "field %q", e.Field)
+			if len(e.Value) != 0 {
+				fmt.Fprintf(&sb, " value %q", e.Value)
+			}
+			sb.WriteString(": ")
+		}
+		sb.WriteString(e.Message())
+		if len(e.ErrAppTag) != 0 {
+			fmt.Fprintf(&sb, " [%s]", e.ErrAppTag)
+		}
+		if _, err := fmt.Fprintln(w, sb.String()); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "Config validation failed with %d error(s)\n", len(errs))
+	return err
+}
+
+// loadConfigDB reads a config_db.json file. Returns an empty DB if
+// fileName is empty.
+func loadConfigDB(fileName string) (*cmn.MapDBAccess, error) {
+	config := map[string]interface{}{}
+	if len(fileName) != 0 {
+		if err := readJSONFile(fileName, &config); err != nil {
+			return nil, err
+		}
+	}
+	return cmn.NewMapDBAccess(config)
+}
+
+func readJSONFile(fileName string, v interface{}) error {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %v", fileName, err)
+	}
+	return nil
+}
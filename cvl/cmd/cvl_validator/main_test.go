@@ -0,0 +1,80 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+func TestLoadConfigDB(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "config_db.json")
+	os.WriteFile(fileName, []byte(`{
+		"VLAN": {"Vlan10": {"vlanid": 10, "members": ["Ethernet0", "Ethernet4"]}}
+	}`), 0644)
+
+	db, err := loadConfigDB(fileName)
+	if err != nil {
+		t.Fatalf("loadConfigDB() failed: %v", err)
+	}
+	hash, _ := db.HGetAll("VLAN|Vlan10").Result()
+	if exp := map[string]string{"vlanid": "10", "members@": "Ethernet0,Ethernet4"}; !reflect.DeepEqual(hash, exp) {
+		t.Errorf("HGetAll(VLAN|Vlan10) = %v; expected %v", hash, exp)
+	}
+
+	if db, err = loadConfigDB(""); err != nil {
+		t.Fatalf("loadConfigDB(\"\") failed: %v", err)
+	}
+	if keys, _ := db.Keys("*").Result(); len(keys) != 0 {
+		t.Errorf("loadConfigDB(\"\") returned keys %v", keys)
+	}
+}
+
+func TestLoadEdits(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "edits.json")
+	os.WriteFile(fileName, []byte(`[
+		{"op": "create", "key": "VLAN|Vlan30", "data": {"vlanid": "30"}},
+		{"op": "update", "key": "VLAN|Vlan10", "data": {"members": ["Ethernet0"]}},
+		{"op": "delete", "key": "VLAN_MEMBER|Vlan10|Ethernet4"}
+	]`), 0644)
+
+	cfgData, err := loadEdits(fileName)
+	if err != nil {
+		t.Fatalf("loadEdits() failed: %v", err)
+	}
+
+	exp := []cmn.CVLEditConfigData{
+		{VType: cmn.VALIDATE_ALL, VOp: cmn.OP_CREATE, Key: "VLAN|Vlan30", Data: map[string]string{"vlanid": "30"}},
+		{VType: cmn.VALIDATE_ALL, VOp: cmn.OP_UPDATE, Key: "VLAN|Vlan10", Data: map[string]string{"members@": "Ethernet0"}},
+		{VType: cmn.VALIDATE_ALL, VOp: cmn.OP_DELETE, Key: "VLAN_MEMBER|Vlan10|Ethernet4", Data: map[string]string{}},
+	}
+	if !reflect.DeepEqual(cfgData, exp) {
+		t.Errorf("loadEdits() = %v; expected %v", cfgData, exp)
+	}
+
+	os.WriteFile(fileName, []byte(`[{"op": "replace", "key": "VLAN|Vlan30"}]`), 0644)
+	if _, err = loadEdits(fileName); err == nil {
+		t.Errorf("loadEdits() accepted unknown operation")
+	}
+}
@@ -0,0 +1,155 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package common
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+)
+
+// EntrySource provides the db entries for LookupEntries and CountEntries.
+// All DBAccess implementations are EntrySources.
+type EntrySource interface {
+	Keys(pattern string) StrSliceResult
+	HGetAll(key string) StrMapResult
+}
+
+// LookupEntries implements DBAccess.Lookup by evaluating the Search criteria
+// in Go, instead of the "filter_entries" lua script. Keys are split into
+// table name and key using tableSep. Returns redis.Nil error if there are
+// no matches.
+func LookupEntries(src EntrySource, s Search, tableSep string) JsonResult {
+	keys, entries, err := searchEntries(src, s, tableSep, true)
+	if err == nil && len(keys) == 0 {
+		err = redis.Nil
+	}
+	if err != nil {
+		return redis.NewStringResult("", err)
+	}
+
+	table := keys[0][:strings.Index(keys[0], tableSep)]
+	tblData := make(map[string]map[string]string, len(keys))
+	for i, key := range keys {
+		tblData[key[len(table)+len(tableSep):]] = entries[i]
+	}
+
+	data, err := json.Marshal(map[string]interface{}{table: tblData})
+	return redis.NewStringResult(string(data), err)
+}
+
+// CountEntries implements DBAccess.Count by evaluating the Search criteria
+// in Go, instead of the "count_entries" lua script. Returns the number of
+// matching entries or the number of values of the WithField in them.
+func CountEntries(src EntrySource, s Search, tableSep string) IntResult {
+	s.Limit = 0 // count_entries ignores the limit
+	loadFields := len(s.Predicate) != 0 || len(s.WithField) != 0
+	keys, entries, err := searchEntries(src, s, tableSep, loadFields)
+	if err != nil || len(s.WithField) == 0 {
+		return redis.NewIntResult(int64(len(keys)), err)
+	}
+
+	var count int64
+	for _, hash := range entries {
+		if _, ok := hash[s.WithField]; ok {
+			count++
+		} else if list, ok := hash[s.WithField+"@"]; ok {
+			count += int64(len(splitNonEmpty(list, ",")))
+		} else if contains(s.KeyNames, s.WithField) {
+			count++
+		}
+	}
+
+	return redis.NewIntResult(count, nil)
+}
+
+// searchEntries returns the sorted list of keys matching the key pattern and
+// predicate of a Search, up to its Limit, and their fields. Fields are loaded
+// only if loadFields is true. Key components are also separated by tableSep.
+func searchEntries(src EntrySource, s Search, tableSep string, loadFields bool) ([]string, []map[string]string, error) {
+	keys, err := src.Keys(s.Pattern).Result()
+	if err == redis.Nil {
+		err = nil
+	}
+	if err != nil || len(keys) == 0 {
+		return nil, nil, err
+	}
+	sort.Strings(keys)
+
+	var pred *Predicate
+	if len(s.Predicate) != 0 {
+		if pred, err = CompilePredicate(s.Predicate); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var matches []string
+	var entries []map[string]string
+
+	for _, key := range keys {
+		if s.Limit > 0 && len(matches) >= s.Limit {
+			break
+		}
+
+		var fields map[string]string
+		if loadFields {
+			if fields, err = src.HGetAll(key).Result(); err != nil && err != redis.Nil {
+				return nil, nil, err
+			}
+		}
+
+		if pred != nil {
+			keyComps := splitNonEmpty(key[strings.Index(key, tableSep)+len(tableSep):], tableSep)
+			ok, err := pred.Match(s.KeyNames, keyComps, fields)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		matches = append(matches, key)
+		entries = append(entries, fields)
+	}
+
+	return matches, entries, nil
+}
+
+func splitNonEmpty(s, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(s, sep) {
+		if len(item) != 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,865 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package common
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Predicate is a Search.Predicate compiled for evaluation in Go.
+// Supports the subset of lua used by cvl for search predicates --
+// string/number literals, nil, true, false, the 'k' and 'h' maps,
+// comparison operators, 'and', 'or', 'not', '..' concatenation and
+// string.match, string.find and string.len functions.
+// Lua patterns are translated to regular expressions; back references,
+// %b and %f items are not supported.
+type Predicate struct {
+	src  string
+	expr luaExpr
+}
+
+// luaExpr evaluates an expression for a db entry. Values are nil, bool,
+// string or float64, like lua types.
+type luaExpr func(k, h luaTable) (interface{}, error)
+
+// luaTable is a lua table holding an entry's key components or hash fields.
+// Table keys are either strings or numbers (for positional key components).
+type luaTable map[interface{}]string
+
+// maxCachedPredicates limits the number of compiled predicates retained by
+// predicateCache. Least recently used ones are dropped beyond it.
+const maxCachedPredicates = 256
+
+// predicateCache is a LRU cache of compiled predicates, indexed by source.
+var predicateCache = struct {
+	sync.Mutex
+	lru   *list.List               // of *Predicate, most recently used first
+	index map[string]*list.Element // predicate source => lru element
+}{
+	lru:   list.New(),
+	index: make(map[string]*list.Element),
+}
+
+func getCachedPredicate(src string) *Predicate {
+	predicateCache.Lock()
+	defer predicateCache.Unlock()
+	if e, ok := predicateCache.index[src]; ok {
+		predicateCache.lru.MoveToFront(e)
+		return e.Value.(*Predicate)
+	}
+	return nil
+}
+
+func putCachedPredicate(p *Predicate) {
+	predicateCache.Lock()
+	defer predicateCache.Unlock()
+	if e, ok := predicateCache.index[p.src]; ok {
+		predicateCache.lru.MoveToFront(e)
+		return
+	}
+	predicateCache.index[p.src] = predicateCache.lru.PushFront(p)
+	for predicateCache.lru.Len() > maxCachedPredicates {
+		e := predicateCache.lru.Back()
+		predicateCache.lru.Remove(e)
+		delete(predicateCache.index, e.Value.(*Predicate).src)
+	}
+}
+
+// CompilePredicate parses a lua predicate statement. The predicate can be
+// a "return" statement or just the condition expression.
+func CompilePredicate(src string) (*Predicate, error) {
+	if p := getCachedPredicate(src); p != nil {
+		return p, nil
+	}
+
+	lex, err := newLuaLexer(src)
+	if err != nil {
+		return nil, err
+	}
+
+	lex.accept("return")
+	expr, err := lex.parseExpr()
+	if err == nil {
+		lex.accept(";")
+		if !lex.atEnd() {
+			err = fmt.Errorf("unexpected %q", lex.peek())
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid predicate %q: %v", src, err)
+	}
+
+	p := &Predicate{src: src, expr: expr}
+	putCachedPredicate(p)
+	return p, nil
+}
+
+// Match evaluates the predicate for a db entry. keyComps are the key components
+// of the entry and keyNames their names; positional indexes are used for 'k'
+// if keyNames is empty. Empty names are ignored, like the lua scripts do.
+// Returns true only if the predicate evaluates to boolean true, like the cvl
+// lua scripts.
+func (p *Predicate) Match(keyNames, keyComps []string, fields map[string]string) (bool, error) {
+	names := make([]string, 0, len(keyNames))
+	for _, name := range keyNames {
+		if len(name) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	k := make(luaTable, len(keyComps))
+	for i, comp := range keyComps {
+		if len(names) == 0 {
+			k[float64(i+1)] = comp
+		} else if i < len(names) {
+			k[names[i]] = comp
+		}
+	}
+
+	h := make(luaTable, len(fields))
+	for name, val := range fields {
+		h[name] = val
+	}
+
+	v, err := p.expr(k, h)
+	if err != nil {
+		return false, fmt.Errorf("predicate %q: %v", p.src, err)
+	}
+	return v == true, nil
+}
+
+func (p *Predicate) String() string {
+	return p.src
+}
+
+// luaLexer splits the predicate into tokens and also acts as the parser.
+type luaLexer struct {
+	src    string
+	tokens []luaToken
+	pos    int
+}
+
+type luaToken struct {
+	kind byte   // 'n' for names, 's' for strings, '9' for numbers, 'o' for operators
+	text string // token text; string tokens hold the unquoted value
+}
+
+var luaOperators = []string{"==", "~=", "<=", ">=", "..", "<", ">", "(", ")", "[", "]", ",", ".", ";"}
+
+func newLuaLexer(src string) (*luaLexer, error) {
+	lex := &luaLexer{src: src}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			s, n, err := unquoteLuaString(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			lex.tokens = append(lex.tokens, luaToken{'s', s})
+			i += n
+		case c == '_' || isLuaLetter(c):
+			j := i + 1
+			for j < len(src) && (src[j] == '_' || isLuaLetter(src[j]) || isLuaDigit(src[j])) {
+				j++
+			}
+			lex.tokens = append(lex.tokens, luaToken{'n', src[i:j]})
+			i = j
+		case isLuaDigit(c):
+			j := i + 1
+			for j < len(src) && (isLuaDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			lex.tokens = append(lex.tokens, luaToken{'9', src[i:j]})
+			i = j
+		default:
+			op := ""
+			for _, o := range luaOperators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if len(op) == 0 {
+				return nil, fmt.Errorf("unexpected character %q in predicate %q", c, src)
+			}
+			lex.tokens = append(lex.tokens, luaToken{'o', op})
+			i += len(op)
+		}
+	}
+	return lex, nil
+}
+
+func isLuaLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isLuaDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// unquoteLuaString parses a quoted string at the beginning of s.
+// Returns the string value and the number of bytes consumed.
+func unquoteLuaString(s string) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == s[0]:
+			return sb.String(), i + 1, nil
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch e := s[i]; e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(e)
+			}
+		case c == '\n':
+			return "", 0, fmt.Errorf("unfinished string %s", s[:i])
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unfinished string %s", s)
+}
+
+func (lex *luaLexer) atEnd() bool {
+	return lex.pos >= len(lex.tokens)
+}
+
+// peek returns the text of the next token, without consuming it.
+func (lex *luaLexer) peek() string {
+	if lex.atEnd() {
+		return "<eof>"
+	}
+	return lex.tokens[lex.pos].text
+}
+
+// accept consumes the next token if it is the given operator or name.
+func (lex *luaLexer) accept(text string) bool {
+	if !lex.atEnd() && lex.tokens[lex.pos].kind != 's' && lex.tokens[lex.pos].text == text {
+		lex.pos++
+		return true
+	}
+	return false
+}
+
+func (lex *luaLexer) expect(text string) error {
+	if !lex.accept(text) {
+		return fmt.Errorf("%q expected near %q", text, lex.peek())
+	}
+	return nil
+}
+
+// parseExpr parses an expression, following the lua operator precedence:
+// or < and < comparison < .. < not
+func (lex *luaLexer) parseExpr() (luaExpr, error) {
+	return lex.parseOr()
+}
+
+func (lex *luaLexer) parseOr() (luaExpr, error) {
+	left, err := lex.parseAnd()
+	for err == nil && lex.accept("or") {
+		var right luaExpr
+		if right, err = lex.parseAnd(); err == nil {
+			left = luaOr(left, right)
+		}
+	}
+	return left, err
+}
+
+func (lex *luaLexer) parseAnd() (luaExpr, error) {
+	left, err := lex.parseCompare()
+	for err == nil && lex.accept("and") {
+		var right luaExpr
+		if right, err = lex.parseCompare(); err == nil {
+			left = luaAnd(left, right)
+		}
+	}
+	return left, err
+}
+
+func luaOr(left, right luaExpr) luaExpr {
+	return func(k, h luaTable) (interface{}, error) {
+		v, err := left(k, h)
+		if err != nil || isLuaTrue(v) {
+			return v, err
+		}
+		return right(k, h)
+	}
+}
+
+func luaAnd(left, right luaExpr) luaExpr {
+	return func(k, h luaTable) (interface{}, error) {
+		v, err := left(k, h)
+		if err != nil || !isLuaTrue(v) {
+			return v, err
+		}
+		return right(k, h)
+	}
+}
+
+func (lex *luaLexer) parseCompare() (luaExpr, error) {
+	left, err := lex.parseConcat()
+	for err == nil {
+		op := lex.peek()
+		if op != "==" && op != "~=" && op != "<" && op != ">" && op != "<=" && op != ">=" {
+			break
+		}
+		lex.pos++
+		var right luaExpr
+		if right, err = lex.parseConcat(); err == nil {
+			left = luaCompare(op, left, right)
+		}
+	}
+	return left, err
+}
+
+func luaCompare(op string, left, right luaExpr) luaExpr {
+	return func(k, h luaTable) (interface{}, error) {
+		l, err := left(k, h)
+		if err != nil {
+			return nil, err
+		}
+		r, err := right(k, h)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op {
+		case "==":
+			return l == r, nil
+		case "~=":
+			return l != r, nil
+		}
+
+		var less, equal bool
+		switch lv := l.(type) {
+		case float64:
+			rv, ok := r.(float64)
+			if !ok {
+				return nil, fmt.Errorf("attempt to compare number with %s", luaTypeName(r))
+			}
+			less, equal = lv < rv, lv == rv
+		case string:
+			rv, ok := r.(string)
+			if !ok {
+				return nil, fmt.Errorf("attempt to compare string with %s", luaTypeName(r))
+			}
+			less, equal = lv < rv, lv == rv
+		default:
+			return nil, fmt.Errorf("attempt to compare %s with %s", luaTypeName(l), luaTypeName(r))
+		}
+
+		switch op {
+		case "<":
+			return less, nil
+		case "<=":
+			return less || equal, nil
+		case ">":
+			return !less && !equal, nil
+		default: // >=
+			return !less, nil
+		}
+	}
+}
+
+// parseConcat parses the right associative '..' operator.
+func (lex *luaLexer) parseConcat() (luaExpr, error) {
+	left, err := lex.parseUnary()
+	if err != nil || !lex.accept("..") {
+		return left, err
+	}
+	right, err := lex.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	return func(k, h luaTable) (interface{}, error) {
+		l, err := luaStringArg(left, k, h, "concatenate")
+		if err != nil {
+			return nil, err
+		}
+		r, err := luaStringArg(right, k, h, "concatenate")
+		if err != nil {
+			return nil, err
+		}
+		return l + r, nil
+	}, nil
+}
+
+func (lex *luaLexer) parseUnary() (luaExpr, error) {
+	if !lex.accept("not") {
+		return lex.parsePrimary()
+	}
+	expr, err := lex.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return func(k, h luaTable) (interface{}, error) {
+		v, err := expr(k, h)
+		return !isLuaTrue(v), err
+	}, nil
+}
+
+func (lex *luaLexer) parsePrimary() (luaExpr, error) {
+	if lex.atEnd() {
+		return nil, fmt.Errorf("unexpected end of predicate")
+	}
+
+	tok := lex.tokens[lex.pos]
+	lex.pos++
+
+	switch tok.kind {
+	case 's':
+		return luaConst(tok.text), nil
+	case '9':
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed number %s", tok.text)
+		}
+		return luaConst(f), nil
+	case 'o':
+		if tok.text != "(" {
+			break
+		}
+		expr, err := lex.parseExpr()
+		if err == nil {
+			err = lex.expect(")")
+		}
+		return expr, err
+	case 'n':
+		switch tok.text {
+		case "nil":
+			return luaConst(nil), nil
+		case "true":
+			return luaConst(true), nil
+		case "false":
+			return luaConst(false), nil
+		case "k", "h":
+			return lex.parseIndex(tok.text == "k")
+		case "string":
+			return lex.parseStringFunc()
+		case "and", "or", "not", "return":
+			break
+		default:
+			// Undefined globals are nil in lua
+			return luaConst(nil), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %q", tok.text)
+}
+
+func luaConst(v interface{}) luaExpr {
+	return func(k, h luaTable) (interface{}, error) {
+		return v, nil
+	}
+}
+
+// parseIndex parses the k['name'], k.name or k[1] style table access.
+func (lex *luaLexer) parseIndex(isKey bool) (luaExpr, error) {
+	var index luaExpr
+	var err error
+	if lex.accept(".") {
+		if lex.atEnd() || lex.tokens[lex.pos].kind != 'n' {
+			return nil, fmt.Errorf("name expected near %q", lex.peek())
+		}
+		index = luaConst(lex.tokens[lex.pos].text)
+		lex.pos++
+	} else if err = lex.expect("["); err == nil {
+		if index, err = lex.parseExpr(); err == nil {
+			err = lex.expect("]")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return func(k, h luaTable) (interface{}, error) {
+		i, err := index(k, h)
+		if err != nil {
+			return nil, err
+		}
+		table := h
+		if isKey {
+			table = k
+		}
+		if v, ok := table[i]; ok {
+			return v, nil
+		}
+		return nil, nil
+	}, nil
+}
+
+// luaStringFuncs are the supported functions of the lua string library.
+var luaStringFuncs = map[string]func(args []interface{}) (interface{}, error){
+	"match": luaStringMatch,
+	"find":  luaStringFind,
+	"len": func(args []interface{}) (interface{}, error) {
+		s, err := luaStringValue(args, 0, "len")
+		return float64(len(s)), err
+	},
+}
+
+// parseStringFunc parses string.xxx(args) function calls.
+func (lex *luaLexer) parseStringFunc() (luaExpr, error) {
+	if err := lex.expect("."); err != nil {
+		return nil, err
+	}
+	name := lex.peek()
+	fn, ok := luaStringFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function string.%s", name)
+	}
+	lex.pos++
+	if err := lex.expect("("); err != nil {
+		return nil, err
+	}
+
+	var args []luaExpr
+	for !lex.accept(")") {
+		if len(args) != 0 {
+			if err := lex.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := lex.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return func(k, h luaTable) (interface{}, error) {
+		vals := make([]interface{}, len(args))
+		for i, arg := range args {
+			v, err := arg(k, h)
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = v
+		}
+		return fn(vals)
+	}, nil
+}
+
+// luaStringMatch implements string.match(s, pattern [, init]).
+// Returns the first capture or the whole match.
+func luaStringMatch(args []interface{}) (interface{}, error) {
+	s, re, init, err := luaPatternArgs(args, "match")
+	if err != nil || init > len(s) {
+		return nil, err
+	}
+	m := re.FindStringSubmatch(s[init:])
+	switch {
+	case m == nil:
+		return nil, nil
+	case len(m) > 1:
+		return m[1], nil
+	default:
+		return m[0], nil
+	}
+}
+
+// luaStringFind implements string.find(s, pattern [, init [, plain]]).
+// Returns the 1-based start position of the match.
+func luaStringFind(args []interface{}) (interface{}, error) {
+	if len(args) > 3 && isLuaTrue(args[3]) {
+		s, err := luaStringValue(args, 0, "find")
+		if err != nil {
+			return nil, err
+		}
+		pattern, err := luaStringValue(args, 1, "find")
+		if err != nil {
+			return nil, err
+		}
+		init, err := luaInitArg(args, len(s))
+		if err != nil || init > len(s) {
+			return nil, err
+		}
+		if i := strings.Index(s[init:], pattern); i >= 0 {
+			return float64(init + i + 1), nil
+		}
+		return nil, nil
+	}
+
+	s, re, init, err := luaPatternArgs(args, "find")
+	if err != nil || init > len(s) {
+		return nil, err
+	}
+	if loc := re.FindStringIndex(s[init:]); loc != nil {
+		return float64(init + loc[0] + 1), nil
+	}
+	return nil, nil
+}
+
+// luaPatternArgs returns the subject string, compiled pattern and 0-based
+// init position arguments of string.match and string.find.
+func luaPatternArgs(args []interface{}, fname string) (string, *regexp.Regexp, int, error) {
+	s, err := luaStringValue(args, 0, fname)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	pattern, err := luaStringValue(args, 1, fname)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	re, err := luaPatternToRegexp(pattern)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	init, err := luaInitArg(args, len(s))
+	return s, re, init, err
+}
+
+// luaInitArg returns the 0-based position for the optional init argument.
+func luaInitArg(args []interface{}, slen int) (int, error) {
+	if len(args) < 3 || args[2] == nil {
+		return 0, nil
+	}
+	f, ok := args[2].(float64)
+	if !ok {
+		return 0, fmt.Errorf("bad argument #3 (number expected, got %s)", luaTypeName(args[2]))
+	}
+	init := int(f)
+	if init < 0 {
+		init += slen + 1
+	}
+	if init < 1 {
+		init = 1
+	}
+	return init - 1, nil
+}
+
+func luaStringValue(args []interface{}, i int, fname string) (string, error) {
+	if i < len(args) {
+		switch v := args[i].(type) {
+		case string:
+			return v, nil
+		case float64:
+			return luaNumberToString(v), nil
+		}
+	}
+	var v interface{}
+	if i < len(args) {
+		v = args[i]
+	}
+	return "", fmt.Errorf("bad argument #%d to '%s' (string expected, got %s)", i+1, fname, luaTypeName(v))
+}
+
+func luaStringArg(expr luaExpr, k, h luaTable, action string) (string, error) {
+	v, err := expr(k, h)
+	if err != nil {
+		return "", err
+	}
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case float64:
+		return luaNumberToString(s), nil
+	}
+	return "", fmt.Errorf("attempt to %s a %s value", action, luaTypeName(v))
+}
+
+func luaNumberToString(f float64) string {
+	return strconv.FormatFloat(f, 'g', 14, 64)
+}
+
+func isLuaTrue(v interface{}) bool {
+	return v != nil && v != false
+}
+
+func luaTypeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	}
+	return "userdata"
+}
+
+var luaPatternCache sync.Map // lua pattern => *regexp.Regexp
+
+// luaClasses maps the lua character classes to regexp character class contents.
+var luaClasses = map[byte]string{
+	'a': `A-Za-z`,
+	'c': `\x00-\x1f\x7f`,
+	'd': `0-9`,
+	'l': `a-z`,
+	'p': `!-/:-@\[-` + "`" + `{-~`,
+	's': `\t\n\v\f\r `,
+	'u': `A-Z`,
+	'w': `0-9A-Za-z`,
+	'x': `0-9A-Fa-f`,
+}
+
+var errLuaPatternEnd = errors.New("malformed pattern (ends with '%')")
+
+// luaPatternToRegexp translates a lua pattern into a regular expression.
+func luaPatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := luaPatternCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("(?s)")
+	p := pattern
+	if strings.HasPrefix(p, "^") {
+		sb.WriteByte('^')
+		p = p[1:]
+	}
+
+	for i := 0; i < len(p); i++ {
+		var item string
+		switch c := p[i]; {
+		case c == '$' && i == len(p)-1:
+			sb.WriteByte('$')
+			continue
+		case c == '(' || c == ')':
+			if c == '(' && i+1 < len(p) && p[i+1] == ')' {
+				return nil, fmt.Errorf("position captures are not supported: %s", pattern)
+			}
+			sb.WriteByte(c)
+			continue
+		case c == '.':
+			item = "."
+		case c == '%':
+			if i++; i == len(p) {
+				return nil, errLuaPatternEnd
+			}
+			cls, err := luaClassToRegexp(p[i], false)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %s", err, pattern)
+			}
+			item = cls
+		case c == '[':
+			end, cls, err := luaSetToRegexp(p, i)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %s", err, pattern)
+			}
+			item, i = cls, end
+		default:
+			item = regexp.QuoteMeta(p[i : i+1])
+		}
+
+		sb.WriteString(item)
+		if i+1 < len(p) {
+			switch p[i+1] {
+			case '*', '+', '?':
+				sb.WriteByte(p[i+1])
+				i++
+			case '-':
+				sb.WriteString("*?")
+				i++
+			}
+		}
+	}
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("malformed pattern %s: %v", pattern, err)
+	}
+	luaPatternCache.Store(pattern, re)
+	return re, nil
+}
+
+// luaClassToRegexp translates a %x item. Returns the class contents
+// without brackets if inSet is true.
+func luaClassToRegexp(c byte, inSet bool) (string, error) {
+	cls, ok := luaClasses[c]
+	negate := false
+	if !ok && c >= 'A' && c <= 'Z' {
+		cls, ok = luaClasses[c+'a'-'A']
+		negate = true
+	}
+
+	switch {
+	case ok && negate && inSet:
+		return "", fmt.Errorf("negated class %%%c in a set is not supported", c)
+	case ok && inSet:
+		return cls, nil
+	case ok && negate:
+		return "[^" + cls + "]", nil
+	case ok:
+		return "[" + cls + "]", nil
+	case isLuaLetter(c) || isLuaDigit(c):
+		return "", fmt.Errorf("unsupported pattern item %%%c", c)
+	case inSet:
+		return `\` + string(c), nil
+	default:
+		return regexp.QuoteMeta(string(c)), nil
+	}
+}
+
+// luaSetToRegexp translates the [set] item starting at p[start].
+// Returns the position of the closing ']' and the regexp character class.
+func luaSetToRegexp(p string, start int) (int, string, error) {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	i := start + 1
+	if i < len(p) && p[i] == '^' {
+		sb.WriteByte('^')
+		i++
+	}
+
+	for first := true; i < len(p); i, first = i+1, false {
+		c := p[i]
+		switch {
+		case c == ']' && !first:
+			sb.WriteByte(']')
+			return i, sb.String(), nil
+		case c == '%':
+			if i++; i == len(p) {
+				return 0, "", errLuaPatternEnd
+			}
+			cls, err := luaClassToRegexp(p[i], true)
+			if err != nil {
+				return 0, "", err
+			}
+			sb.WriteString(cls)
+		case c == '-' && !first && i+1 < len(p) && p[i+1] != ']':
+			sb.WriteByte('-')
+		default:
+			if strings.IndexByte(`\[]^-`, c) >= 0 {
+				sb.WriteByte('\\')
+			}
+			sb.WriteByte(c)
+		}
+	}
+
+	return 0, "", errors.New("malformed pattern (missing ']')")
+}
@@ -0,0 +1,135 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package common
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPredicateMatch(t *testing.T) {
+	keyNames := []string{"name", "ifname"}
+	keyComps := []string{"Vlan10", "Ethernet4"}
+	fields := map[string]string{
+		"tagging_mode": "tagged",
+		"mtu":          "9100",
+		"members@":     "Ethernet0,Ethernet4",
+		"ip":           "10.1.1.1",
+	}
+
+	for _, tc := range []struct {
+		pred string
+		exp  bool
+	}{
+		{"return (k['name'] == 'Vlan10')", true},
+		{"k.ifname == 'Ethernet4'", true},
+		{"(k['name'] == 'Vlan10' and h['tagging_mode'] ~= 'untagged')", true},
+		{"(k['name'] == 'Vlan20' or h['tagging_mode'] == 'untagged')", false},
+		{"((k['name'] == 'Vlan20') or (h[\"mtu\"] == '9100'))", true},
+		{"not (h['vrf'] ~= nil)", true},
+		{"h['vrf'] == nil and k['x'] == nil", true},
+		{"h['mtu']", false}, // only boolean true is a match
+		{"h['mtu'] > '9200'", false},
+		{"string.len(h['mtu']) == 4", true},
+		{"(string.match('Vlan10,Vlan20', k['name']..'[,]*') ~= nil)", true},
+		{"(string.match('Vlan1,Vlan20', k['name']..'[,]*') ~= nil)", false},
+		{"(string.find(h['members@']..',', 'Ethernet4,') ~= nil)", true},
+		{"(string.find(h['members@']..',', 'Ethernet8,') ~= nil)", false},
+		{"string.find(h['members@'], 'Ethernet4') == 11", true},
+		{"string.find(h['ip'], '.', 1, true) == 3", true},
+		{"string.match(h['ip'], '^(%d+)%.') == '10'", true},
+		{"string.match(h['ip'], '^[%d.]+$') == h['ip']", true},
+		{"string.match(h['tagging_mode'], '^%a-d$') ~= nil", true},
+		{"string.match(h['mtu'], '%D') == nil", true},
+	} {
+		p, err := CompilePredicate(tc.pred)
+		if err != nil {
+			t.Errorf("CompilePredicate(%s) failed: %v", tc.pred, err)
+			continue
+		}
+		if ok, err := p.Match(keyNames, keyComps, fields); ok != tc.exp || err != nil {
+			t.Errorf("Match(%s) = %v, %v; expected %v", tc.pred, ok, err, tc.exp)
+		}
+	}
+
+	// Positional key components when there are no key names
+	p, _ := CompilePredicate("return (k[1] == 'Vlan10' and k[2] == 'Ethernet4')")
+	if ok, _ := p.Match([]string{""}, keyComps, fields); !ok {
+		t.Errorf("Match(%s) failed for positional keys", p)
+	}
+}
+
+func TestPredicateErrors(t *testing.T) {
+	for _, pred := range []string{
+		"",
+		"return",
+		"k['name'] ==",
+		"(k['name'] == 'Vlan10'",
+		"h['name'] = 'x'",
+		"string.gsub(h['x'], 'a', 'b')",
+		"'unfinished",
+	} {
+		if _, err := CompilePredicate(pred); err == nil {
+			t.Errorf("CompilePredicate(%s) did not fail", pred)
+		}
+	}
+
+	// Runtime errors, like lua
+	for _, pred := range []string{
+		"h['x']..'a' == 'a'",
+		"h['x'] < 'a'",
+		"string.find(h['x'], 'a') ~= nil",
+		"string.match('(a)', '%b()') ~= nil",
+	} {
+		p, err := CompilePredicate(pred)
+		if err != nil {
+			t.Errorf("CompilePredicate(%s) failed: %v", pred, err)
+		} else if _, err = p.Match(nil, nil, map[string]string{}); err == nil {
+			t.Errorf("Match(%s) did not fail", pred)
+		}
+	}
+}
+
+func TestPredicateCacheBound(t *testing.T) {
+	first, err := CompilePredicate("k['name'] == 'cache0'")
+	if err != nil {
+		t.Fatalf("CompilePredicate failed: %v", err)
+	}
+	if p, _ := CompilePredicate("k['name'] == 'cache0'"); p != first {
+		t.Errorf("Predicate was not cached")
+	}
+
+	for i := 1; i <= maxCachedPredicates; i++ {
+		if _, err := CompilePredicate(fmt.Sprintf("k['name'] == 'cache%d'", i)); err != nil {
+			t.Fatalf("CompilePredicate failed: %v", err)
+		}
+	}
+
+	predicateCache.Lock()
+	n := predicateCache.lru.Len()
+	_, found := predicateCache.index[first.src]
+	predicateCache.Unlock()
+	if n != maxCachedPredicates {
+		t.Errorf("Cache holds %d predicates; want %d", n, maxCachedPredicates)
+	}
+	if found {
+		t.Errorf("Least recently used predicate was not evicted")
+	}
+}
@@ -0,0 +1,214 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+)
+
+// MapDBAccess is a DBAccess serving CONFIG_DB data from memory, instead of
+// redis. It is built from config_db.json style data and evaluates the
+// Search predicates in Go. Can be used to run cvl in tools, unit tests and
+// pipelines without a redis server. Data is not modified after creation;
+// hence a MapDBAccess can be shared by concurrent validation sessions.
+type MapDBAccess struct {
+	entries map[string]map[string]string // redis key => hash fields
+}
+
+// NewMapDBAccess creates a MapDBAccess from the config_db.json data, which
+// maps table names to keys to fields. Field values can be strings, numbers,
+// booleans or lists (for leaf-lists).
+// E.g, {"VLAN": {"Vlan10": {"vlanid": "10", "members": ["Ethernet0"]}}}
+func NewMapDBAccess(config map[string]interface{}) (*MapDBAccess, error) {
+	db := &MapDBAccess{entries: make(map[string]map[string]string)}
+	for table, tableData := range config {
+		entries, ok := tableData.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("table %s: object expected, got %T", table, tableData)
+		}
+		for key, entryData := range entries {
+			fields, ok := entryData.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s|%s: object expected, got %T", table, key, entryData)
+			}
+			db.entries[table+"|"+key] = ToRedisFields(fields)
+		}
+	}
+	return db, nil
+}
+
+// NewMapDBAccessFromJSON creates a MapDBAccess from config_db.json contents.
+func NewMapDBAccessFromJSON(data []byte) (*MapDBAccess, error) {
+	var config map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&config); err != nil {
+		return nil, err
+	}
+	return NewMapDBAccess(config)
+}
+
+// ToRedisFields converts the fields of an entry from config_db.json format
+// to the redis hash format. Leaf-list values are stored in "field@", comma
+// separated; an entry without fields gets the "NULL" field.
+func ToRedisFields(fields map[string]interface{}) map[string]string {
+	hash := make(map[string]string, len(fields))
+	for name, val := range fields {
+		if list, ok := val.([]interface{}); ok {
+			items := make([]string, len(list))
+			for i, item := range list {
+				items[i] = fmt.Sprint(item)
+			}
+			hash[name+"@"] = strings.Join(items, ",")
+		} else {
+			hash[name] = fmt.Sprint(val)
+		}
+	}
+
+	if len(hash) == 0 {
+		hash["NULL"] = "NULL"
+	}
+	return hash
+}
+
+func (db *MapDBAccess) Exists(key string) IntResult {
+	if _, ok := db.entries[key]; ok {
+		return redis.NewIntResult(1, nil)
+	}
+	return redis.NewIntResult(0, nil)
+}
+
+func (db *MapDBAccess) Keys(pattern string) StrSliceResult {
+	keys, err := db.keys(pattern)
+	return redis.NewStringSliceResult(keys, err)
+}
+
+func (db *MapDBAccess) HGet(key, field string) StrResult {
+	if val, ok := db.entries[key][field]; ok {
+		return redis.NewStringResult(val, nil)
+	}
+	return redis.NewStringResult("", redis.Nil)
+}
+
+func (db *MapDBAccess) HMGet(key string, fields ...string) SliceResult {
+	vals := make([]interface{}, len(fields))
+	for i, field := range fields {
+		if val, ok := db.entries[key][field]; ok {
+			vals[i] = val
+		}
+	}
+	return redis.NewSliceResult(vals, nil)
+}
+
+func (db *MapDBAccess) HGetAll(key string) StrMapResult {
+	hash := make(map[string]string, len(db.entries[key]))
+	for field, val := range db.entries[key] {
+		hash[field] = val
+	}
+	return redis.NewStringStringMapResult(hash, nil)
+}
+
+func (db *MapDBAccess) Pipeline() PipeResult {
+	return mapDBPipe{db}
+}
+
+// Lookup returns the entries matching the search criteria, in the same
+// format as the "filter_entries" lua script. Returns redis.Nil error
+// if there are no matches.
+func (db *MapDBAccess) Lookup(s Search) JsonResult {
+	return LookupEntries(db, s, "|")
+}
+
+// Count returns the number of entries matching the search criteria, or the
+// number of values of the search field in them, like the "count_entries"
+// lua script.
+func (db *MapDBAccess) Count(s Search) IntResult {
+	return CountEntries(db, s, "|")
+}
+
+// keys returns the sorted list of keys matching a redis glob pattern.
+func (db *MapDBAccess) keys(pattern string) ([]string, error) {
+	re, err := globToRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for key := range db.entries {
+		if re.MatchString(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// globToRegexp converts a redis glob pattern to a regular expression.
+// Unlike KeyMatch, character classes are supported.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("(?s)^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteByte('.')
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				break
+			}
+			sb.WriteString(pattern[i : i+end+2])
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	sb.WriteByte('$')
+	return regexp.Compile(sb.String())
+}
+
+// mapDBPipe runs the commands immediately, as the data is local.
+type mapDBPipe struct {
+	*MapDBAccess
+}
+
+func (mapDBPipe) Exec() error {
+	return nil
+}
+
+func (mapDBPipe) Close() {}
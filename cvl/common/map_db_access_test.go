@@ -0,0 +1,159 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-redis/redis/v7"
+)
+
+func newTestMapDB(t *testing.T, config string) *MapDBAccess {
+	db, err := NewMapDBAccessFromJSON([]byte(config))
+	if err != nil {
+		t.Fatalf("NewMapDBAccessFromJSON() failed: %v", err)
+	}
+	return db
+}
+
+func TestMapDBAccess(t *testing.T) {
+	db := newTestMapDB(t, `{
+		"VLAN": {
+			"Vlan10": {"vlanid": 10, "members": ["Ethernet0", "Ethernet4"]},
+			"Vlan20": {"vlanid": "20"}
+		},
+		"VLAN_MEMBER": {
+			"Vlan10|Ethernet0": {"tagging_mode": "tagged"},
+			"Vlan10|Ethernet4": {}
+		},
+		"INTERFACE": {
+			"Ethernet8|10.0.0.1/31": {}
+		}
+	}`)
+
+	t.Run("HGetAll", func(tt *testing.T) {
+		hash, _ := db.HGetAll("VLAN|Vlan10").Result()
+		exp := map[string]string{"vlanid": "10", "members@": "Ethernet0,Ethernet4"}
+		if !reflect.DeepEqual(hash, exp) {
+			tt.Errorf("HGetAll(VLAN|Vlan10) = %v; expected %v", hash, exp)
+		}
+		if hash, _ = db.HGetAll("VLAN_MEMBER|Vlan10|Ethernet4").Result(); hash["NULL"] != "NULL" {
+			tt.Errorf("HGetAll(VLAN_MEMBER|Vlan10|Ethernet4) = %v; expected NULL field", hash)
+		}
+	})
+
+	t.Run("HGet", func(tt *testing.T) {
+		if v, err := db.HGet("VLAN|Vlan20", "vlanid").Result(); v != "20" || err != nil {
+			tt.Errorf("HGet(VLAN|Vlan20, vlanid) = %q, %v", v, err)
+		}
+		if _, err := db.HGet("VLAN|Vlan20", "mtu").Result(); err != redis.Nil {
+			tt.Errorf("HGet(VLAN|Vlan20, mtu) returned err=%v; expected redis.Nil", err)
+		}
+	})
+
+	t.Run("Exists", func(tt *testing.T) {
+		if n, _ := db.Exists("VLAN|Vlan20").Result(); n != 1 {
+			tt.Errorf("Exists(VLAN|Vlan20) = %d", n)
+		}
+		if n, _ := db.Exists("VLAN|Vlan30").Result(); n != 0 {
+			tt.Errorf("Exists(VLAN|Vlan30) = %d", n)
+		}
+	})
+
+	t.Run("Keys", func(tt *testing.T) {
+		for pattern, exp := range map[string][]string{
+			"VLAN|*":                  {"VLAN|Vlan10", "VLAN|Vlan20"},
+			"VLAN|Vlan?0":             {"VLAN|Vlan10", "VLAN|Vlan20"},
+			"VLAN|Vlan[1]*":           {"VLAN|Vlan10"},
+			"VLAN_MEMBER|*|Ethernet4": {"VLAN_MEMBER|Vlan10|Ethernet4"},
+			"INTERFACE|*|*":           {"INTERFACE|Ethernet8|10.0.0.1/31"},
+			"PORT|*":                  {},
+		} {
+			if keys, _ := db.Keys(pattern).Result(); !reflect.DeepEqual(keys, exp) {
+				tt.Errorf("Keys(%s) = %v; expected %v", pattern, keys, exp)
+			}
+		}
+	})
+
+	t.Run("Count", func(tt *testing.T) {
+		for _, tc := range []struct {
+			s   Search
+			exp int64
+		}{
+			{Search{Pattern: "VLAN|*"}, 2},
+			{Search{Pattern: "VLAN|*", WithField: "members"}, 2},
+			{Search{Pattern: "VLAN|*", Predicate: "return (h['vlanid'] == '20')"}, 1},
+			{Search{Pattern: "VLAN_MEMBER|Vlan10|*", WithField: "tagging_mode"}, 1},
+			{Search{Pattern: "VLAN_MEMBER|*", WithField: "name", KeyNames: []string{"name", "ifname"}}, 2},
+			{Search{Pattern: "VLAN_MEMBER|*", Predicate: "k['ifname'] == 'Ethernet4'", KeyNames: []string{"name", "ifname"}}, 1},
+			{Search{Pattern: "VLAN_MEMBER|*", Predicate: "k[2] ~= 'Ethernet4'"}, 1},
+		} {
+			if n, err := db.Count(tc.s).Result(); n != tc.exp || err != nil {
+				tt.Errorf("Count(%+v) = %d, %v; expected %d", tc.s, n, err, tc.exp)
+			}
+		}
+	})
+
+	t.Run("Lookup", func(tt *testing.T) {
+		for _, tc := range []struct {
+			s   Search
+			exp string
+		}{
+			{Search{Pattern: "VLAN|Vlan2*"}, `{"VLAN":{"Vlan20":{"vlanid":"20"}}}`},
+			{Search{Pattern: "VLAN|*", Limit: 1}, `{"VLAN":{"Vlan10":{"members@":"Ethernet0,Ethernet4","vlanid":"10"}}}`},
+			{Search{Pattern: "VLAN|*", Predicate: "return (h['members@'] ~= nil and " +
+				"string.find(h['members@']..',', 'Ethernet4,') ~= nil)"},
+				`{"VLAN":{"Vlan10":{"members@":"Ethernet0,Ethernet4","vlanid":"10"}}}`},
+			{Search{Pattern: "VLAN_MEMBER|*", Predicate: "return (h['tagging_mode'] == 'tagged')", KeyNames: []string{"name", "ifname"}},
+				`{"VLAN_MEMBER":{"Vlan10|Ethernet0":{"tagging_mode":"tagged"}}}`},
+		} {
+			if data, err := db.Lookup(tc.s).Result(); data != tc.exp || err != nil {
+				tt.Errorf("Lookup(%+v) = %s, %v; expected %s", tc.s, data, err, tc.exp)
+			}
+		}
+
+		for _, s := range []Search{
+			{Pattern: "PORT|*"},
+			{Pattern: "VLAN|*", Predicate: "return (h['vlanid'] == '30')"},
+		} {
+			if data, err := db.Lookup(s).Result(); data != "" || err != redis.Nil {
+				tt.Errorf("Lookup(%+v) = %s, %v; expected redis.Nil", s, data, err)
+			}
+		}
+
+		s := Search{Pattern: "VLAN|*", Predicate: "return (h['mtu']..',' == '')"}
+		if _, err := db.Lookup(s).Result(); err == nil {
+			tt.Errorf("Lookup(%+v) did not fail for nil concatenation", s)
+		}
+	})
+}
+
+func TestNewMapDBAccess_Invalid(t *testing.T) {
+	for _, config := range []string{
+		`{"VLAN": ["Vlan10"]}`,
+		`{"VLAN": {"Vlan10": "10"}}`,
+		`[]`,
+	} {
+		if _, err := NewMapDBAccessFromJSON([]byte(config)); err == nil {
+			t.Errorf("NewMapDBAccessFromJSON(%s) did not fail", config)
+		}
+	}
+}
@@ -118,10 +118,12 @@ type modelTableInfo struct {
 	refFromTables    []tblFieldPair      //list of table or table/field referring to this table
 	custValidation   map[string][]string // Map for custom validation node and function name
 	dfltLeafVal      map[string]string   //map of leaf names and default value
+	dfltLeafList     map[string]bool     //leaf-lists having default value
 	mandatoryNodes   map[string]bool     //map of leaf names and mandatory flag & leaf-list with min-elements > 0
 	dependentOnTable string              // Name of table on which it is dependent
 	dependentTables  []string            // list of dependent tables
 	has_static_key   bool                // True, if LIST has sonic-extension:tbl-key
+	unique           [][]string          // Leaf names of each 'unique' statement
 }
 
 // CVLErrorInfo Struct for CVL Error Info
@@ -154,6 +156,8 @@ type CVL struct {
 	yv         *YValidator               //Custom YANG validator for validating external dependencies
 	custvCache custv.CustValidationCache //Custom validation cache per session
 	dbAccess   cmn.DBAccess              //DB access interface
+	maxErrors  int                       //Max errors to collect in ValidateEditConfigAll
+	dbNum      uint8                     //DB whose tables are validated
 }
 
 // Struct for model namepsace and prefix
@@ -461,7 +465,12 @@ func storeModelInfo(modelFile string, module *yparser.YParserModule) {
 
 		tInfo := modelTableInfo{modelName: modelName}
 
-		tInfo.dbNum = dbNameToDbNum[lInfo.DbName]
+		if dbNum, exists := dbNameToDbNum[lInfo.DbName]; exists {
+			tInfo.dbNum = dbNum
+		} else {
+			CVL_LOG(WARNING, "Unknown db-name %s for list %s", lInfo.DbName, lInfo.ListName)
+			tInfo.dbNum = INVALID_DB
+		}
 		tInfo.redisTableName = lInfo.RedisTableName
 		tInfo.module = module
 		tInfo.redisKeyDelim = lInfo.RedisKeyDelim
@@ -473,11 +482,25 @@ func storeModelInfo(modelFile string, module *yparser.YParserModule) {
 		tInfo.mandatoryNodes = lInfo.MandatoryNodes
 		tInfo.dependentOnTable = lInfo.DependentOnTable
 
+		//Store unique constraints; only the direct child leaves are supported
+		for _, leafNames := range lInfo.Unique {
+			if strings.Contains(strings.Join(leafNames, " "), "/") {
+				CVL_LOG(WARNING, "Ignoring unsupported unique statement %v in list %s",
+					leafNames, lInfo.ListName)
+				continue
+			}
+			tInfo.unique = append(tInfo.unique, leafNames)
+		}
+
 		//store default values used in must and when exp
 		tInfo.dfltLeafVal = make(map[string]string, len(lInfo.DfltLeafVal))
 		for nodeName, val := range lInfo.DfltLeafVal {
 			tInfo.dfltLeafVal[nodeName] = val
 		}
+		tInfo.dfltLeafList = make(map[string]bool, len(lInfo.DfltLeafList))
+		for nodeName := range lInfo.DfltLeafList {
+			tInfo.dfltLeafList[nodeName] = true
+		}
 
 		//Store leafref details
 		tInfo.leafRef = make(map[string][]*leafRefInfo, len(lInfo.LeafRef))
@@ -702,12 +725,12 @@ func SplitRedisKey(key string) (string, string) {
 func splitRedisKey(key string) (string, string) {
 
 	var foundIdx int = -1
-	//Check with all key delim
+	//Check with all key delim; table name ends at the first one.
+	//Key may contain other delims, e.g ':' in IPv6 address.
 	for keyDelim := range modelInfo.allKeyDelims {
-		foundIdx = strings.Index(key, keyDelim)
-		if foundIdx >= 0 {
+		if idx := strings.Index(key, keyDelim); idx >= 0 && (foundIdx < 0 || idx < foundIdx) {
 			//Matched with key delim
-			break
+			foundIdx = idx
 		}
 	}
 
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"runtime"
 	"strings"
@@ -39,6 +40,7 @@ import (
 
 	//lint:ignore ST1001 This is safe to dot import for util package
 	. "github.com/Azure/sonic-mgmt-common/cvl/internal/util"
+	"github.com/antchfx/jsonquery"
 	"github.com/antchfx/xmlquery"
 )
 
@@ -145,7 +147,15 @@ func Initialize() CVLRetCode {
 	modelInfo.allKeyDelims = make(map[string]bool)                      //all key delimiter
 	modelInfo.redisTableToYangList = make(map[string][]string)          //Redis table to Yang list map
 	modelInfo.redisTableToListKeys = make(map[string]map[string]string) //Redis table to Yang List key map
-	dbNameToDbNum = map[string]uint8{"APPL_DB": APPL_DB, "CONFIG_DB": CONFIG_DB}
+	dbNameToDbNum = map[string]uint8{
+		"APPL_DB":         APPL_DB,
+		"ASIC_DB":         ASIC_DB,
+		"COUNTERS_DB":     COUNTERS_DB,
+		"LOGLEVEL_DB":     LOGLEVEL_DB,
+		"CONFIG_DB":       CONFIG_DB,
+		"FLEX_COUNTER_DB": FLEX_COUNTER_DB,
+		"STATE_DB":        STATE_DB,
+	}
 
 	// Load all YIN schema files
 	if retCode := loadSchemaFiles(); retCode != CVL_SUCCESS {
@@ -191,6 +201,7 @@ func ValidationSessOpen(dbAccess cmn.DBAccess) (*CVL, CVLRetCode) {
 	cvl.custvCache.Data = make(map[string]interface{})
 	cvl.custvCache.Hint = make(map[string]interface{})
 	cvl.dbAccess = dbAccess
+	cvl.dbNum = CONFIG_DB
 
 	if cvl == nil || cvl.yp == nil {
 		return nil, CVL_FAILURE
@@ -199,6 +210,27 @@ func ValidationSessOpen(dbAccess cmn.DBAccess) (*CVL, CVLRetCode) {
 	return cvl, CVL_SUCCESS
 }
 
+// ValidationSessOpenDB opens a validation session for the tables of a
+// DB other than CONFIG_DB, e.g "APPL_DB" or "STATE_DB". Tables belong to
+// the DB specified by the sonic-extension:db-name of their YANG container.
+// Data of dbAccess is used for the references to the tables of same DB;
+// use SetDBAccess to resolve references to the tables of other DBs.
+func ValidationSessOpenDB(dbName string, dbAccess cmn.DBAccess) (*CVL, CVLRetCode) {
+	dbNum, exists := dbNameToDbNum[dbName]
+	if !exists {
+		CVL_LOG(WARNING, "ValidationSessOpenDB(): unknown DB %s", dbName)
+		return nil, CVL_FAILURE
+	}
+
+	c, ret := ValidationSessOpen(dbAccess)
+	if ret == CVL_SUCCESS {
+		c.dbNum = dbNum
+		c.yp.SetStateData(dbNum != CONFIG_DB)
+	}
+
+	return c, ret
+}
+
 func ValidationSessClose(c *CVL) CVLRetCode {
 	c.yp.DestroyCache()
 	c = nil
@@ -206,10 +238,213 @@ func ValidationSessClose(c *CVL) CVLRetCode {
 	return CVL_SUCCESS
 }
 
-func (c *CVL) ValidateStartupConfig(jsonData string) CVLRetCode {
-	//Check config data syntax
-	//Finally validate
-	return CVL_NOT_IMPLEMENTED
+// ResetSession discards the edits validated so far, and the data cached
+// by the session. The session can then be reused for validating unrelated
+// edits. The DB access and max errors settings are retained.
+func (c *CVL) ResetSession() {
+	c.yp.DestroyCache()
+	c.tmpDbCache = make(map[string]interface{})
+	c.requestCache = make(map[string]map[string][]*cmn.RequestCacheType)
+	c.depDataCache = make(map[string]interface{})
+	c.maxTableElem = make(map[string]int)
+	c.batchLeaf = nil
+	c.yv.root = &xmlquery.Node{Type: xmlquery.DocumentNode}
+	c.custvCache.Data = make(map[string]interface{})
+	c.custvCache.Hint = make(map[string]interface{})
+}
+
+// SetMaxErrors sets the maximum number of errors collected by
+// ValidateEditConfigAll before validation stops. Values less than 2
+// stop the validation at the first error, which is the default.
+func (c *CVL) SetMaxErrors(maxErrors int) {
+	c.maxErrors = maxErrors
+}
+
+// SetDBAccess sets the data access for the tables of another DB. It is used
+// for evaluating the references to those tables, e.g an APPL_DB table entry
+// having a leafref to a CONFIG_DB table.
+func (c *CVL) SetDBAccess(dbName string, dbAccess cmn.DBAccess) CVLRetCode {
+	dbNum, exists := dbNameToDbNum[dbName]
+	if !exists {
+		CVL_LOG(WARNING, "SetDBAccess(): unknown DB %s", dbName)
+		return CVL_FAILURE
+	}
+
+	m, ok := c.dbAccess.(*multiDBAccess)
+	if !ok {
+		m = &multiDBAccess{dbNum: c.dbNum, dbAccess: c.dbAccess,
+			others: make(map[uint8]cmn.DBAccess)}
+		c.dbAccess = m
+	}
+	if dbNum == c.dbNum {
+		m.dbAccess = dbAccess
+	} else {
+		m.others[dbNum] = dbAccess
+	}
+
+	return CVL_SUCCESS
+}
+
+// TableHasSchema tells whether the YANG schema defines the redis table
+// in the given DB.
+func TableHasSchema(dbName, tableName string) bool {
+	dbNum, exists := dbNameToDbNum[dbName]
+	return exists && getTableDbNum(tableName) == dbNum &&
+		len(modelInfo.redisTableToYangList[tableName]) != 0
+}
+
+// ValidateStartupConfig validates a complete config, in config_db.json format,
+// without using the data present in Redis. Every entry is checked for syntax,
+// mandatory fields and max-elements; leafref, must and when expressions are
+// evaluated against the given config only. Entries of tables without schema
+// are skipped. All errors found are returned; the return code is CVL_SUCCESS
+// if there are none, otherwise the code of the first error.
+func (c *CVL) ValidateStartupConfig(jsonData string) (errs []CVLErrorInfo, ret CVLRetCode) {
+	ts := time.Now()
+
+	// Dependent data must come from the given config only
+	dbAccess := c.dbAccess
+	c.dbAccess = offlineDBAccess{}
+
+	defer func() {
+		CVL_LOG(INFO_API, "ValidateStartupConfig(): %d error(s), time taken %v", len(errs), time.Since(ts))
+		c.dbAccess = dbAccess
+		c.clearTmpDbCache()
+		c.yv.root = &xmlquery.Node{Type: xmlquery.DocumentNode}
+		c.depDataCache = make(DepDataCacheType)
+		c.yp.DestroyCache()
+	}()
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(jsonData), &v); err != nil {
+		return []CVLErrorInfo{newCfgDataError("", "", "Invalid JSON data: "+err.Error())}, CVL_SYNTAX_ERROR
+	}
+	dataMap, ok := v.(map[string]interface{})
+	if !ok {
+		return []CVLErrorInfo{newCfgDataError("", "", "Config data is not a JSON object")}, CVL_SYNTAX_ERROR
+	}
+
+	c.clearTmpDbCache()
+	c.yv.root = &xmlquery.Node{Type: xmlquery.DocumentNode}
+
+	//Step 1: Check syntax of each entry and build the complete YANG tree
+	failedEntries := make(map[string]bool)
+	for _, tbl := range sortedMapKeys(dataMap) {
+		tblData, ok := dataMap[tbl].(map[string]interface{})
+		if !ok {
+			errs = append(errs, newCfgDataError(tbl, "", "Table data is not a JSON object"))
+			continue
+		}
+
+		listSize := make(map[string]int)
+		for _, key := range sortedMapKeys(tblData) {
+			yangListName := getRedisTblToYangList(tbl, key)
+			if _, exists := modelInfo.tableInfo[yangListName]; !exists {
+				CVL_LOG(INFO_DEBUG, "ValidateStartupConfig(): schema not found for %s, skipping", tbl)
+				continue
+			}
+			listSize[yangListName]++
+
+			entryMap := map[string]interface{}{tbl: map[string]interface{}{key: tblData[key]}}
+			if cvlErrObj := c.validateStartupConfigEntry(&entryMap); cvlErrObj.ErrCode != CVL_SUCCESS {
+				errs = append(errs, fillErrorKeyInfo(cvlErrObj, tbl, key))
+				failedEntries[tbl+"|"+key] = true
+			}
+		}
+
+		//Check max-elements constraint
+		for yangListName, size := range listSize {
+			maxSize := modelInfo.tableInfo[yangListName].redisTableSize
+			if maxSize != -1 && size > maxSize {
+				errs = append(errs, CVLErrorInfo{
+					TableName:        tbl,
+					ErrCode:          CVL_SYNTAX_MAXIMUM_INVALID,
+					CVLErrDetails:    cvlErrorMap[CVL_SYNTAX_MAXIMUM_INVALID],
+					ErrAppTag:        "too-many-elements",
+					Msg:              "Max elements limit reached",
+					ConstraintErrMsg: fmt.Sprintf("Max elements limit %v reached", maxSize),
+				})
+			}
+		}
+
+		//Check unique constraints
+		errs = append(errs, checkStartupUniqueConstraints(tbl, tblData, failedEntries)...)
+	}
+
+	//Step 2: Validate leafref, when and must expressions of every entry
+	for topNode := c.yv.root.FirstChild; topNode != nil; topNode = topNode.NextSibling {
+		for tblNode := topNode.FirstChild; tblNode != nil; tblNode = tblNode.NextSibling {
+			for listNode := tblNode.FirstChild; listNode != nil; listNode = listNode.NextSibling {
+				if !strings.HasSuffix(listNode.Data, "_LIST") || len(listNode.Attr) == 0 {
+					continue
+				}
+				yangListName := strings.TrimSuffix(listNode.Data, "_LIST")
+				key := listNode.Attr[0].Value
+				if failedEntries[tblNode.Data+"|"+key] {
+					continue
+				}
+
+				cvlErrObj := c.validateSemantics(listNode, yangListName, key,
+					&cmn.CVLEditConfigData{VType: cmn.VALIDATE_NONE, VOp: cmn.OP_NONE})
+				if cvlErrObj.ErrCode != CVL_SUCCESS {
+					cvlErrObj.TableName = tblNode.Data
+					errs = append(errs, fillErrorKeyInfo(cvlErrObj, tblNode.Data, key))
+				}
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		return errs, errs[0].ErrCode
+	}
+	return nil, CVL_SUCCESS
+}
+
+// validateStartupConfigEntry checks syntax of one table entry and adds it to
+// the YANG validator tree.
+func (c *CVL) validateStartupConfigEntry(entryMap *map[string]interface{}) CVLErrorInfo {
+	root, cvlErrObj := c.translateToYang(entryMap, true)
+	if cvlErrObj.ErrCode != CVL_SUCCESS {
+		cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
+		return cvlErrObj
+	}
+	defer c.yp.FreeNode(root)
+
+	cvlErrObj, _ = c.validateSyntax(root, nil)
+	return cvlErrObj
+}
+
+func newCfgDataError(tbl, key, msg string) CVLErrorInfo {
+	cvlErrObj := CVLErrorInfo{
+		TableName:     tbl,
+		ErrCode:       CVL_SYNTAX_ERROR,
+		CVLErrDetails: cvlErrorMap[CVL_SYNTAX_ERROR],
+		Msg:           msg,
+	}
+	if len(key) != 0 {
+		cvlErrObj.Keys = splitKeyComponents(tbl, key)
+	}
+	return cvlErrObj
+}
+
+// fillErrorKeyInfo fills table and keys in the error, if missing
+func fillErrorKeyInfo(cvlErrObj CVLErrorInfo, tbl, key string) CVLErrorInfo {
+	if len(cvlErrObj.TableName) == 0 {
+		cvlErrObj.TableName = tbl
+	}
+	if len(cvlErrObj.Keys) == 0 {
+		cvlErrObj.Keys = splitKeyComponents(tbl, key)
+	}
+	return cvlErrObj
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // ValidateIncrementalConfig Steps:
@@ -295,6 +530,20 @@ func (c *CVL) ValidateConfig(jsonData string) CVLRetCode {
 
 // ValidateEditConfig Validate config data based on edit operation
 func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErrorInfo, ret CVLRetCode) {
+	errs, ret := c.ValidateEditConfigAll(cfgData)
+	if len(errs) != 0 {
+		cvlErr = errs[0]
+	}
+
+	return cvlErr, ret
+}
+
+// ValidateEditConfigAll validates config data based on edit operation,
+// like ValidateEditConfig, but returns all the errors found. Errors are
+// collected across the entries up to the limit set by SetMaxErrors;
+// by default validation stops at the first error. The return code is
+// CVL_SUCCESS if there are no errors, otherwise the code of the first error.
+func (c *CVL) ValidateEditConfigAll(cfgData []cmn.CVLEditConfigData) (errs []CVLErrorInfo, ret CVLRetCode) {
 	if c.dbAccess == nil {
 		CVL_LOG(FATAL, "ValidateEditConfig: DB Access object is Nil")
 		panic(c.dbAccess)
@@ -303,8 +552,8 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 	ts := time.Now()
 
 	defer func() {
-		if cvlErr.ErrCode != CVL_SUCCESS {
-			CVL_LOG(WARNING, "ValidateEditConfig() failed: %+v", cvlErr)
+		if len(errs) != 0 {
+			CVL_LOG(WARNING, "ValidateEditConfig() failed: %+v", errs)
 			// On CVL failure, log config data
 			CVL_LOG(WARNING, "Config Data: %v", cfgData)
 		}
@@ -316,7 +565,6 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 		c.yp.DestroyCache()
 	}()
 
-	var cvlErrObj CVLErrorInfo
 	checkSyntax := true
 
 	caller := ""
@@ -331,7 +579,7 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 
 	if SkipValidation() {
 		CVL_LOG(INFO_TRACE, "Skipping CVL validation.")
-		return cvlErrObj, CVL_SUCCESS
+		return nil, CVL_SUCCESS
 	}
 
 	//Type cast to custom validation cfg data
@@ -342,6 +590,9 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 	//c.yv.root.FirstChild = nil
 	//c.yv.root.LastChild = nil
 
+	//Entries which already have an error reported
+	failed := make(map[int]bool)
+
 	//Step 1: Get requested data first
 	//add all dependent data to be fetched from Redis
 	requestedData := make(map[string]interface{})
@@ -368,26 +619,49 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 
 		//Invalid table name or invalid key separator
 		if key == "" {
-			cvlErrObj.ErrCode = CVL_SYNTAX_ERROR
-			cvlErrObj.Msg = "Invalid table or key for " + cfgData[i].Key
-			cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-			return cvlErrObj, CVL_SYNTAX_ERROR
+			failed[i] = true
+			if c.addError(&errs, CVLErrorInfo{
+				ErrCode:       CVL_SYNTAX_ERROR,
+				Msg:           "Invalid table or key for " + cfgData[i].Key,
+				CVLErrDetails: cvlErrorMap[CVL_SYNTAX_ERROR],
+			}) {
+				return errs, errs[0].ErrCode
+			}
+			continue
+		}
+
+		//Table must belong to the DB of the session
+		if getTableDbNum(tbl) != c.dbNum {
+			failed[i] = true
+			if c.addError(&errs, CVLErrorInfo{
+				ErrCode:       CVL_SYNTAX_ERROR,
+				TableName:     tbl,
+				Keys:          splitKeyComponents(tbl, key),
+				Msg:           "Table " + tbl + " does not belong to " + dbNumToDbName(c.dbNum),
+				CVLErrDetails: cvlErrorMap[CVL_SYNTAX_ERROR],
+			}) {
+				return errs, errs[0].ErrCode
+			}
+			continue
 		}
 
 		switch cfgData[i].VOp {
 		case cmn.OP_CREATE:
 			//Check max-element constraint
 			if ret := c.checkMaxElemConstraint(cmn.OP_CREATE, tbl, key); ret != CVL_SUCCESS {
-				cvlErrObj.ErrCode = CVL_SYNTAX_ERROR
-				cvlErrObj.TableName = tbl
-				cvlErrObj.Keys = splitKeyComponents(tbl, key)
-				cvlErrObj.ErrAppTag = "too-many-elements"
-				cvlErrObj.Msg = "Max elements limit reached"
-				cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-				cvlErrObj.ConstraintErrMsg = fmt.Sprintf("Max elements limit %v reached",
-					modelInfo.tableInfo[tbl].redisTableSize)
-
-				return cvlErrObj, CVL_SYNTAX_ERROR
+				failed[i] = true
+				if c.addError(&errs, CVLErrorInfo{
+					ErrCode:       CVL_SYNTAX_ERROR,
+					TableName:     tbl,
+					Keys:          splitKeyComponents(tbl, key),
+					ErrAppTag:     "too-many-elements",
+					Msg:           "Max elements limit reached",
+					CVLErrDetails: cvlErrorMap[CVL_SYNTAX_ERROR],
+					ConstraintErrMsg: fmt.Sprintf("Max elements limit %v reached",
+						modelInfo.tableInfo[tbl].redisTableSize),
+				}) {
+					return errs, errs[0].ErrCode
+				}
 			}
 
 		case cmn.OP_UPDATE:
@@ -399,41 +673,51 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			if len(cfgData[i].Data) > 0 {
 				//Check constraints for deleting field(s)
 				for field := range cfgData[i].Data {
+					var cvlErrObj CVLErrorInfo
+
 					if c.checkDeleteConstraint(cfgData, cfgData[i], tbl, key, field) != CVL_SUCCESS {
-						cvlErrObj.ErrCode = CVL_SEMANTIC_ERROR
-						cvlErrObj.TableName = tbl
-						cvlErrObj.Keys = splitKeyComponents(tbl, key)
-						cvlErrObj.Field = field
-						cvlErrObj.Msg = "Validation failed for Delete operation, given instance is in use"
-						cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-						cvlErrObj.ErrAppTag = "instance-in-use"
+						cvlErrObj = CVLErrorInfo{
+							ErrCode:       CVL_SEMANTIC_ERROR,
+							TableName:     tbl,
+							Keys:          splitKeyComponents(tbl, key),
+							Field:         field,
+							Msg:           "Validation failed for Delete operation, given instance is in use",
+							CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_ERROR],
+							ErrAppTag:     "instance-in-use",
+						}
 						cvlErrObj.ConstraintErrMsg = cvlErrObj.Msg
-						return cvlErrObj, CVL_SEMANTIC_ERROR
-					}
-
-					// Cannot delete leaf-list field having min-elements > 0
-					if isLeaflistHasMinElems(tbl, field) {
-						cvlErrObj.ErrCode = CVL_SYNTAX_MINIMUM_INVALID
-						cvlErrObj.Msg = "Mandatory field getting deleted"
-						cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-						cvlErrObj.TableName = tbl
-						cvlErrObj.Keys = splitKeyComponents(tbl, key)
-						cvlErrObj.Field = strings.TrimSuffix(field, "@") // To match libyang error
-						return cvlErrObj, cvlErrObj.ErrCode
+					} else if isLeaflistHasMinElems(tbl, field) {
+						// Cannot delete leaf-list field having min-elements > 0
+						cvlErrObj = CVLErrorInfo{
+							ErrCode:       CVL_SYNTAX_MINIMUM_INVALID,
+							Msg:           "Mandatory field getting deleted",
+							CVLErrDetails: cvlErrorMap[CVL_SYNTAX_MINIMUM_INVALID],
+							TableName:     tbl,
+							Keys:          splitKeyComponents(tbl, key),
+							Field:         strings.TrimSuffix(field, "@"), // To match libyang error
+						}
+					} else if len(field) != 0 && isMandatoryTrueNode(getRedisTblToYangList(tbl, key), field) {
+						//Check mandatory node deletion
+						cvlErrObj = CVLErrorInfo{
+							ErrCode:       CVL_SEMANTIC_ERROR,
+							Msg:           "Mandatory field getting deleted",
+							TableName:     tbl,
+							Keys:          splitKeyComponents(tbl, key),
+							Field:         field,
+							CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_ERROR],
+							ErrAppTag:     "mandatory-field-delete",
+						}
+						cvlErrObj.ConstraintErrMsg = cvlErrObj.Msg
+					} else {
+						continue
 					}
 
-					//Check mandatory node deletion
-					if len(field) != 0 && isMandatoryTrueNode(getRedisTblToYangList(tbl, key), field) {
-						cvlErrObj.ErrCode = CVL_SEMANTIC_ERROR
-						cvlErrObj.Msg = "Mandatory field getting deleted"
-						cvlErrObj.TableName = tbl
-						cvlErrObj.Keys = splitKeyComponents(tbl, key)
-						cvlErrObj.Field = field
-						cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-						cvlErrObj.ErrAppTag = "mandatory-field-delete"
-						cvlErrObj.ConstraintErrMsg = cvlErrObj.Msg
-						return cvlErrObj, CVL_SEMANTIC_ERROR
+					failed[i] = true
+					if c.addError(&errs, cvlErrObj) {
+						return errs, errs[0].ErrCode
 					}
+					//One error per entry
+					break
 				}
 			} else {
 				//Entire entry to be deleted
@@ -443,14 +727,18 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 
 				//Now check delete constraints
 				if c.checkDeleteConstraint(cfgData, cfgData[i], tbl, key, "") != CVL_SUCCESS {
-					cvlErrObj.ErrCode = CVL_SEMANTIC_ERROR
-					cvlErrObj.TableName = tbl
-					cvlErrObj.Keys = splitKeyComponents(tbl, key)
-					cvlErrObj.Msg = "Validation failed for Delete operation, given instance is in use"
-					cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-					cvlErrObj.ErrAppTag = "instance-in-use"
-					cvlErrObj.ConstraintErrMsg = cvlErrObj.Msg
-					return cvlErrObj, CVL_SEMANTIC_ERROR
+					failed[i] = true
+					if c.addError(&errs, CVLErrorInfo{
+						ErrCode:          CVL_SEMANTIC_ERROR,
+						TableName:        tbl,
+						Keys:             splitKeyComponents(tbl, key),
+						Msg:              "Validation failed for Delete operation, given instance is in use",
+						CVLErrDetails:    cvlErrorMap[CVL_SEMANTIC_ERROR],
+						ErrAppTag:        "instance-in-use",
+						ConstraintErrMsg: "Validation failed for Delete operation, given instance is in use",
+					}) {
+						return errs, errs[0].ErrCode
+					}
 				}
 				checkSyntax = false
 			}
@@ -467,9 +755,11 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 		if err == nil {
 			jsonData = string(jsonDataBytes)
 		} else {
-			cvlErrObj.ErrCode = CVL_SYNTAX_ERROR
-			cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-			return cvlErrObj, CVL_SYNTAX_ERROR
+			c.addError(&errs, CVLErrorInfo{
+				ErrCode:       CVL_SYNTAX_ERROR,
+				CVLErrDetails: cvlErrorMap[CVL_SYNTAX_ERROR],
+			})
+			return errs, errs[0].ErrCode
 		}
 
 		TRACE_LOG(TRACE_LIBYANG, "Requested JSON Data = [%s]\n", jsonData)
@@ -484,11 +774,13 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 		depData := c.fetchDataToTmpCache() //fetch data to temp cache for temporary validation
 		if checkSyntax {
 			if cvlErrObj, cvlRetCode := c.validateSyntax(yang, depData); cvlRetCode != CVL_SUCCESS {
-				return cvlErrObj, cvlRetCode
+				c.collectSyntaxErrors(&errs, cvlErrObj, cfgData, failed)
+				return errs, errs[0].ErrCode
 			}
 		}
 	} else {
-		return errN, errN.ErrCode
+		c.collectSyntaxErrors(&errs, errN, cfgData, failed)
+		return errs, errs[0].ErrCode
 	}
 
 	//Step 3 : Check keys and perform semantics validation
@@ -498,6 +790,10 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			continue
 		}
 
+		if failed[i] {
+			continue
+		}
+
 		tbl, key := splitRedisKey(cfgData[i].Key)
 
 		//Step 3.1 : Check keys
@@ -507,11 +803,15 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			n, err1 := c.dbAccess.Exists(cfgData[i].Key).Result()
 			if err1 == nil && n > 0 {
 				CVL_LOG(WARNING, "\nValidateEditConfig(): Key = %s already exists", cfgData[i].Key)
-				cvlErrObj.ErrCode = CVL_SEMANTIC_KEY_ALREADY_EXIST
-				cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-				cvlErrObj.TableName = tbl
-				cvlErrObj.Keys = splitKeyComponents(tbl, key)
-				return cvlErrObj, CVL_SEMANTIC_KEY_ALREADY_EXIST
+				if c.addError(&errs, CVLErrorInfo{
+					ErrCode:       CVL_SEMANTIC_KEY_ALREADY_EXIST,
+					CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_KEY_ALREADY_EXIST],
+					TableName:     tbl,
+					Keys:          splitKeyComponents(tbl, key),
+				}) {
+					return errs, errs[0].ErrCode
+				}
+				continue
 			} else if err1 != nil {
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_CREATE - Key = %s ", cfgData[i].Key)
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_CREATE - Err = %v ", err1)
@@ -524,11 +824,15 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			if err1 != nil || n == 0 { //key must exists
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_UPDATE - Key = %s does not exist", cfgData[i].Key)
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_UPDATE - Err = %v ", err1)
-				cvlErrObj.ErrCode = CVL_SEMANTIC_KEY_NOT_EXIST
-				cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-				cvlErrObj.TableName = tbl
-				cvlErrObj.Keys = splitKeyComponents(tbl, key)
-				return cvlErrObj, CVL_SEMANTIC_KEY_NOT_EXIST
+				if c.addError(&errs, CVLErrorInfo{
+					ErrCode:       CVL_SEMANTIC_KEY_NOT_EXIST,
+					CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_KEY_NOT_EXIST],
+					TableName:     tbl,
+					Keys:          splitKeyComponents(tbl, key),
+				}) {
+					return errs, errs[0].ErrCode
+				}
+				continue
 			}
 
 			// Skip validation if UPDATE is received with only NULL field
@@ -544,11 +848,15 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			if err1 != nil || n == 0 { //key must exists
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_DELETE - Key = %s does not exist", cfgData[i].Key)
 				CVL_LOG(WARNING, "\nValidateEditConfig(): OP_DELETE - Err = %v ", err1)
-				cvlErrObj.ErrCode = CVL_SEMANTIC_KEY_NOT_EXIST
-				cvlErrObj.CVLErrDetails = cvlErrorMap[cvlErrObj.ErrCode]
-				cvlErrObj.TableName = tbl
-				cvlErrObj.Keys = splitKeyComponents(tbl, key)
-				return cvlErrObj, CVL_SEMANTIC_KEY_NOT_EXIST
+				if c.addError(&errs, CVLErrorInfo{
+					ErrCode:       CVL_SEMANTIC_KEY_NOT_EXIST,
+					CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_KEY_NOT_EXIST],
+					TableName:     tbl,
+					Keys:          splitKeyComponents(tbl, key),
+				}) {
+					return errs, errs[0].ErrCode
+				}
+				continue
 			}
 
 			c.yp.SetOperation("DELETE")
@@ -572,10 +880,13 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 		}
 
 		//Step 3.2 : Run all custom validations
-		cvlErrObj = c.doCustomValidation(node, custvCfg, &custvCfg[i], yangListName,
+		cvlErrObj := c.doCustomValidation(node, custvCfg, &custvCfg[i], yangListName,
 			tbl, key)
 		if cvlErrObj.ErrCode != CVL_SUCCESS {
-			return cvlErrObj, cvlErrObj.ErrCode
+			if c.addError(&errs, cvlErrObj) {
+				return errs, errs[0].ErrCode
+			}
+			continue
 		}
 
 		if cfgData[i].ReplaceOp {
@@ -591,9 +902,22 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 			}
 		}
 
-		//Step 3.3 : Perform semantic validation
+		//Step 3.3 : Check unique constraints
+		if cfgData[i].VOp != cmn.OP_DELETE {
+			if cvlErrObj = c.checkUniqueConstraints(yangListName, tbl, key); cvlErrObj.ErrCode != CVL_SUCCESS {
+				if c.addError(&errs, cvlErrObj) {
+					return errs, errs[0].ErrCode
+				}
+				continue
+			}
+		}
+
+		//Step 3.4 : Perform semantic validation
 		if cvlErrObj = c.validateSemantics(node, yangListName, key, &cfgData[i]); cvlErrObj.ErrCode != CVL_SUCCESS {
-			return cvlErrObj, cvlErrObj.ErrCode
+			if c.addError(&errs, cvlErrObj) {
+				return errs, errs[0].ErrCode
+			}
+			continue
 		}
 
 		// Setting VType to VALIDATE_NONE for already validated cfgData
@@ -601,7 +925,87 @@ func (c *CVL) ValidateEditConfig(cfgData []cmn.CVLEditConfigData) (cvlErr CVLErr
 		c.markCfgDataValidated(&cfgData[i], tbl, key)
 	}
 
-	return cvlErrObj, CVL_SUCCESS
+	if len(errs) != 0 {
+		return errs, errs[0].ErrCode
+	}
+
+	return nil, CVL_SUCCESS
+}
+
+// addError records a validation error and tells whether validation
+// should stop, i.e. the session does not collect multiple errors or
+// the maximum number of errors has been reached.
+func (c *CVL) addError(errs *[]CVLErrorInfo, cvlErrObj CVLErrorInfo) bool {
+	*errs = append(*errs, cvlErrObj)
+	return len(*errs) >= c.maxErrors
+}
+
+// collectSyntaxErrors records the syntax error reqErr found while validating
+// the whole request. When the session collects multiple errors, each of the
+// remaining entries is validated on its own, so that all the entries having
+// invalid data get reported; reqErr is reported only if none of them fails.
+func (c *CVL) collectSyntaxErrors(errs *[]CVLErrorInfo, reqErr CVLErrorInfo,
+	cfgData []cmn.CVLEditConfigData, failed map[int]bool) {
+
+	found := false
+
+	if c.maxErrors > 1 {
+		//Dependent data is fetched again per entry
+		c.clearTmpDbCache()
+
+		for i := range cfgData {
+			if cfgData[i].VType != cmn.VALIDATE_ALL ||
+				cfgData[i].VOp == cmn.OP_DELETE || failed[i] {
+				continue
+			}
+
+			if cvlErrObj := c.validateEntrySyntax(cfgData[i]); cvlErrObj.ErrCode != CVL_SUCCESS {
+				found = true
+				failed[i] = true
+				if c.addError(errs, cvlErrObj) {
+					return
+				}
+			}
+		}
+	}
+
+	if !found {
+		c.addError(errs, reqErr)
+	}
+}
+
+// validateEntrySyntax validates the syntax of a single config data item.
+// For update, the item is merged with the existing data from Redis.
+func (c *CVL) validateEntrySyntax(cfgDataItem cmn.CVLEditConfigData) CVLErrorInfo {
+	entryData := make(map[string]interface{})
+	tbl, key := c.addCfgDataItem(&entryData, cfgDataItem)
+
+	data, err := jsonquery.ParseJsonMap(&entryData)
+	if err != nil {
+		return newCfgDataError(tbl, key, err.Error())
+	}
+
+	var root *yparser.YParserNode
+	defer func() { c.yp.FreeNode(root) }()
+
+	for jsonNode := data.FirstChild; jsonNode != nil; jsonNode = jsonNode.NextSibling {
+		if cvlErrObj := c.generateYangParserData(jsonNode, &root); cvlErrObj.ErrCode != CVL_SUCCESS {
+			return fillErrorKeyInfo(cvlErrObj, tbl, key)
+		}
+	}
+
+	var depData *yparser.YParserNode
+	if cfgDataItem.VOp == cmn.OP_UPDATE {
+		c.addTableEntryToCache(tbl, key)
+		depData = c.fetchDataToTmpCache()
+	}
+
+	cvlErrObj, _ := c.validateSyntax(root, depData)
+	if cvlErrObj.ErrCode != CVL_SUCCESS {
+		return fillErrorKeyInfo(cvlErrObj, tbl, key)
+	}
+
+	return cvlErrObj
 }
 
 func (c *CVL) markCfgDataValidated(cfgData *cmn.CVLEditConfigData, tbl, key string) {
@@ -1264,3 +1668,50 @@ func ReconfigureRedisOptions(opt redis.Options) {
 		CVL_LEVEL_LOG(INFO, "ReconfigureRedisOptions -- Failed to update redisClient")
 	}
 }
+
+// offlineDBAccess is a cmn.DBAccess without any data. It is used when the
+// config being validated is the complete config, which is already present
+// in the YANG validator tree.
+type offlineDBAccess struct{}
+
+func (offlineDBAccess) Exists(key string) cmn.IntResult {
+	return redis.NewIntResult(0, nil)
+}
+
+func (offlineDBAccess) Keys(pattern string) cmn.StrSliceResult {
+	return redis.NewStringSliceResult([]string{}, nil)
+}
+
+func (offlineDBAccess) HGet(key, field string) cmn.StrResult {
+	return redis.NewStringResult("", redis.Nil)
+}
+
+func (offlineDBAccess) HMGet(key string, fields ...string) cmn.SliceResult {
+	return redis.NewSliceResult(make([]interface{}, len(fields)), nil)
+}
+
+func (offlineDBAccess) HGetAll(key string) cmn.StrMapResult {
+	return redis.NewStringStringMapResult(map[string]string{}, nil)
+}
+
+func (offlineDBAccess) Pipeline() cmn.PipeResult {
+	return offlinePipe{}
+}
+
+func (offlineDBAccess) Lookup(s cmn.Search) cmn.JsonResult {
+	return redis.NewStringResult("", nil)
+}
+
+func (offlineDBAccess) Count(s cmn.Search) cmn.IntResult {
+	return redis.NewIntResult(0, nil)
+}
+
+type offlinePipe struct {
+	offlineDBAccess
+}
+
+func (offlinePipe) Exec() error {
+	return nil
+}
+
+func (offlinePipe) Close() {}
@@ -0,0 +1,162 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl
+
+import (
+	"strings"
+
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+// getTableDbNum returns the DB of a redis table, as specified by the
+// sonic-extension:db-name in its schema. CONFIG_DB is assumed for the
+// tables without schema.
+func getTableDbNum(tableName string) uint8 {
+	for _, yangList := range modelInfo.redisTableToYangList[tableName] {
+		if tInfo := modelInfo.tableInfo[yangList]; tInfo != nil {
+			return tInfo.dbNum
+		}
+	}
+	return CONFIG_DB
+}
+
+func dbNumToDbName(dbNum uint8) string {
+	for name, num := range dbNameToDbNum {
+		if num == dbNum && name != "FLEX_COUNTER_DB" {
+			return name
+		}
+	}
+	return "INVALID_DB"
+}
+
+// getKeyTableName returns the table name prefix of a redis key or key pattern.
+func getKeyTableName(key string) string {
+	tblEnd := len(key)
+	for keyDelim := range modelInfo.allKeyDelims {
+		if idx := strings.Index(key, keyDelim); idx >= 0 && idx < tblEnd {
+			tblEnd = idx
+		}
+	}
+	return key[:tblEnd]
+}
+
+// multiDBAccess is a cmn.DBAccess which accesses the data of a table
+// through the DBAccess of the DB it belongs to. Tables of the DBs without
+// a DBAccess are accessed through the session's DBAccess.
+type multiDBAccess struct {
+	dbNum    uint8                  //DB of the session
+	dbAccess cmn.DBAccess           //DBAccess of the session
+	others   map[uint8]cmn.DBAccess //DBAccess of other DBs
+}
+
+func (m *multiDBAccess) get(key string) cmn.DBAccess {
+	_, dbAccess := m.route(key)
+	return dbAccess
+}
+
+// route returns the DB of the table in key and its DBAccess.
+func (m *multiDBAccess) route(key string) (uint8, cmn.DBAccess) {
+	if dbNum := getTableDbNum(getKeyTableName(key)); dbNum != m.dbNum {
+		if dbAccess, exists := m.others[dbNum]; exists {
+			return dbNum, dbAccess
+		}
+	}
+	return m.dbNum, m.dbAccess
+}
+
+func (m *multiDBAccess) Exists(key string) cmn.IntResult {
+	return m.get(key).Exists(key)
+}
+
+func (m *multiDBAccess) Keys(pattern string) cmn.StrSliceResult {
+	return m.get(pattern).Keys(pattern)
+}
+
+func (m *multiDBAccess) HGet(key, field string) cmn.StrResult {
+	return m.get(key).HGet(key, field)
+}
+
+func (m *multiDBAccess) HMGet(key string, fields ...string) cmn.SliceResult {
+	return m.get(key).HMGet(key, fields...)
+}
+
+func (m *multiDBAccess) HGetAll(key string) cmn.StrMapResult {
+	return m.get(key).HGetAll(key)
+}
+
+func (m *multiDBAccess) Lookup(s cmn.Search) cmn.JsonResult {
+	return m.get(s.Pattern).Lookup(s)
+}
+
+func (m *multiDBAccess) Count(s cmn.Search) cmn.IntResult {
+	return m.get(s.Pattern).Count(s)
+}
+
+func (m *multiDBAccess) Pipeline() cmn.PipeResult {
+	return &multiDBPipe{multiDBAccess: m, pipes: make(map[uint8]cmn.PipeResult)}
+}
+
+// multiDBPipe queues the commands in the pipeline of the DB they belong to.
+type multiDBPipe struct {
+	*multiDBAccess
+	pipes map[uint8]cmn.PipeResult //pipeline per DB
+}
+
+func (p *multiDBPipe) pipe(key string) cmn.PipeResult {
+	dbNum, dbAccess := p.route(key)
+	pipe, exists := p.pipes[dbNum]
+	if !exists {
+		pipe = dbAccess.Pipeline()
+		p.pipes[dbNum] = pipe
+	}
+	return pipe
+}
+
+func (p *multiDBPipe) Keys(pattern string) cmn.StrSliceResult {
+	return p.pipe(pattern).Keys(pattern)
+}
+
+func (p *multiDBPipe) HGet(key, field string) cmn.StrResult {
+	return p.pipe(key).HGet(key, field)
+}
+
+func (p *multiDBPipe) HMGet(key string, fields ...string) cmn.SliceResult {
+	return p.pipe(key).HMGet(key, fields...)
+}
+
+func (p *multiDBPipe) HGetAll(key string) cmn.StrMapResult {
+	return p.pipe(key).HGetAll(key)
+}
+
+func (p *multiDBPipe) Exec() error {
+	var err error
+	for _, pipe := range p.pipes {
+		if e := pipe.Exec(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (p *multiDBPipe) Close() {
+	for _, pipe := range p.pipes {
+		pipe.Close()
+	}
+}
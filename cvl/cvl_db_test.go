@@ -0,0 +1,155 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl_test
+
+import (
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/cvl"
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+// Test the validation of APPL_DB tables with leafref to CONFIG_DB tables
+func TestValidateEditConfig_ApplDB(t *testing.T) {
+	applDB, _ := cmn.NewMapDBAccess(nil)
+	configDB, err := cmn.NewMapDBAccess(map[string]interface{}{
+		"VLAN": map[string]interface{}{
+			"Vlan10": map[string]interface{}{"vlanid": "10"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewMapDBAccess() failed: %v", err)
+	}
+
+	c, status := cvl.ValidationSessOpenDB("APPL_DB", applDB)
+	if status != cvl.CVL_SUCCESS {
+		t.Fatalf("ValidationSessOpenDB() failed: %v", status)
+	}
+	defer cvl.ValidationSessClose(c)
+	c.SetDBAccess("CONFIG_DB", configDB)
+
+	validate := func(key string) CVLErrorInfo {
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   key,
+			Data:  map[string]string{"vni": "100"},
+		}})
+		return res
+	}
+
+	t.Run("valid", func(tt *testing.T) {
+		verifyErr(tt, validate("EVPN_REMOTE_VNI_TABLE:Vlan10:10.1.1.1"), Success)
+	})
+	t.Run("bad_leafref", func(tt *testing.T) {
+		verifyErr(tt, validate("EVPN_REMOTE_VNI_TABLE:Vlan20:10.1.1.1"), CVLErrorInfo{
+			ErrCode:   cvl.CVL_SEMANTIC_DEPENDENT_DATA_MISSING,
+			TableName: "EVPN_REMOTE_VNI_TABLE",
+			ErrAppTag: "instance-required",
+		})
+	})
+	t.Run("bad_key", func(tt *testing.T) {
+		verifyErr(tt, validate("EVPN_REMOTE_VNI_TABLE:Vlan10:abc"), CVLErrorInfo{
+			ErrCode:   cvl.CVL_SYNTAX_ERROR,
+			TableName: "EVPN_REMOTE_VNI_TABLE",
+		})
+	})
+	t.Run("other_db_table", func(tt *testing.T) {
+		verifyErr(tt, validate("VXLAN_TUNNEL_TABLE:vtep1"), CVLErrorInfo{
+			ErrCode:   cvl.CVL_SYNTAX_ERROR,
+			TableName: "VXLAN_TUNNEL_TABLE",
+		})
+	})
+}
+
+// Test that a reset session validates again, with the same DB access
+func TestResetSession(t *testing.T) {
+	applDB, _ := cmn.NewMapDBAccess(nil)
+	configDB, _ := cmn.NewMapDBAccess(map[string]interface{}{
+		"VLAN": map[string]interface{}{
+			"Vlan10": map[string]interface{}{"vlanid": "10"},
+		},
+	})
+
+	c, status := cvl.ValidationSessOpenDB("APPL_DB", applDB)
+	if status != cvl.CVL_SUCCESS {
+		t.Fatalf("ValidationSessOpenDB() failed: %v", status)
+	}
+	defer cvl.ValidationSessClose(c)
+	c.SetDBAccess("CONFIG_DB", configDB)
+
+	cfgData := []CVLEditConfigData{{
+		VType: VALIDATE_ALL,
+		VOp:   OP_CREATE,
+		Key:   "EVPN_REMOTE_VNI_TABLE:Vlan10:10.1.1.1",
+		Data:  map[string]string{"vni": "100"},
+	}}
+	for i := 0; i < 2; i++ {
+		res, _ := c.ValidateEditConfig(cfgData)
+		verifyErr(t, res, Success)
+		c.ResetSession()
+	}
+
+	res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+		VType: VALIDATE_ALL,
+		VOp:   OP_CREATE,
+		Key:   "EVPN_REMOTE_VNI_TABLE:Vlan20:10.1.1.1",
+		Data:  map[string]string{"vni": "100"},
+	}})
+	verifyErr(t, res, CVLErrorInfo{
+		ErrCode:   cvl.CVL_SEMANTIC_DEPENDENT_DATA_MISSING,
+		TableName: "EVPN_REMOTE_VNI_TABLE",
+		ErrAppTag: "instance-required",
+	})
+}
+
+// Test that CONFIG_DB sessions reject the tables of other DBs
+func TestValidateEditConfig_ConfigDB_StateTable(t *testing.T) {
+	c := NewTestSession(t)
+	res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+		VType: VALIDATE_ALL,
+		VOp:   OP_CREATE,
+		Key:   "VXLAN_TUNNEL_TABLE|vtep1",
+		Data:  map[string]string{"src_ip": "10.1.1.1"},
+	}})
+	verifyErr(t, res, CVLErrorInfo{
+		ErrCode:   cvl.CVL_SYNTAX_ERROR,
+		TableName: "VXLAN_TUNNEL_TABLE",
+	})
+}
+
+func TestTableHasSchema(t *testing.T) {
+	for _, tc := range []struct {
+		db, table string
+		exp       bool
+	}{
+		{"CONFIG_DB", "VLAN", true},
+		{"APPL_DB", "VLAN", false},
+		{"APPL_DB", "EVPN_REMOTE_VNI_TABLE", true},
+		{"STATE_DB", "VXLAN_TUNNEL_TABLE", true},
+		{"CONFIG_DB", "VXLAN_TUNNEL_TABLE", false},
+		{"APPL_DB", "UNKNOWN_TABLE", false},
+		{"BAD_DB", "VLAN", false},
+	} {
+		if got := cvl.TableHasSchema(tc.db, tc.table); got != tc.exp {
+			t.Errorf("TableHasSchema(%s, %s) = %v; expected %v", tc.db, tc.table, got, tc.exp)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
+
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+// DefaultValueMode tells how ApplyDefaults handles the YANG default values.
+type DefaultValueMode uint8
+
+const (
+	// DefaultValueFill adds the missing fields having default values
+	DefaultValueFill DefaultValueMode = iota
+	// DefaultValueStrip removes the fields equal to their default values
+	DefaultValueStrip
+)
+
+// ApplyDefaults returns a copy of the edit data with the YANG default values
+// filled or stripped, as per mode. Only OP_CREATE entries are changed, since
+// the fields missing in other operations are not set to default values in DB.
+// A default value is filled only if the 'when' conditions of the field are
+// true for the entry; the conditions can refer to other tables in DB.
+// Entries of tables without schema are returned as is.
+func (c *CVL) ApplyDefaults(cfgData []cmn.CVLEditConfigData, mode DefaultValueMode) ([]cmn.CVLEditConfigData, CVLRetCode) {
+	defer func() {
+		c.clearTmpDbCache()
+		c.yv.root = &xmlquery.Node{Type: xmlquery.DocumentNode}
+	}()
+
+	result := make([]cmn.CVLEditConfigData, len(cfgData))
+	for i, cfgDataItem := range cfgData {
+		result[i] = cfgDataItem
+		if cfgDataItem.VOp != cmn.OP_CREATE {
+			continue
+		}
+
+		tbl, key := splitRedisKey(cfgDataItem.Key)
+		tInfo, exists := modelInfo.tableInfo[getRedisTblToYangList(tbl, key)]
+		if !exists || len(tInfo.dfltLeafVal) == 0 {
+			continue
+		}
+
+		data := make(map[string]string, len(cfgDataItem.Data)+len(tInfo.dfltLeafVal))
+		for field, val := range cfgDataItem.Data {
+			data[field] = val
+		}
+
+		switch mode {
+		case DefaultValueFill:
+			if ret := c.fillDefaults(tbl, key, data); ret != CVL_SUCCESS {
+				return nil, ret
+			}
+		case DefaultValueStrip:
+			stripDefaults(tInfo, data)
+		default:
+			CVL_LOG(WARNING, "ApplyDefaults(): unknown mode %v", mode)
+			return nil, CVL_FAILURE
+		}
+
+		result[i].Data = data
+	}
+
+	return result, CVL_SUCCESS
+}
+
+// fillDefaults adds the default values of the fields missing in the
+// entry data, if their 'when' conditions are true.
+func (c *CVL) fillDefaults(tbl, key string, data map[string]string) CVLRetCode {
+	yangListName := getRedisTblToYangList(tbl, key)
+	tInfo := modelInfo.tableInfo[yangListName]
+
+	missing := []string{}
+	for leafName := range tInfo.dfltLeafVal {
+		if _, exists := data[defaultFieldName(tInfo, leafName)]; !exists {
+			missing = append(missing, leafName)
+		}
+	}
+	if len(missing) == 0 {
+		return CVL_SUCCESS
+	}
+	sort.Strings(missing)
+
+	//Unconditional defaults first; the when expressions of the
+	//others can refer to them
+	conditional := map[string][]whenExpCtx{}
+	for _, leafName := range missing {
+		if whenExps := getLeafWhenExps(tInfo, leafName); len(whenExps) != 0 {
+			conditional[leafName] = whenExps
+		} else {
+			data[defaultFieldName(tInfo, leafName)] = tInfo.dfltLeafVal[leafName]
+		}
+	}
+
+	//Conditional defaults can enable each other, repeat till no change
+	for changed := len(conditional) != 0; changed; {
+		changed = false
+
+		//YANG data of the entry, with default values filled so far,
+		//is needed for evaluating when expressions
+		c.clearTmpDbCache()
+		c.yv.root = &xmlquery.Node{Type: xmlquery.DocumentNode}
+		entryData := map[string]interface{}{}
+		c.addCfgDataItem(&entryData, cmn.CVLEditConfigData{
+			VType: cmn.VALIDATE_NONE, VOp: cmn.OP_CREATE,
+			Key: tbl + tInfo.redisKeyDelim + key, Data: data})
+		if _, cvlErrObj := c.translateToYang(&entryData, false); cvlErrObj.ErrCode != CVL_SUCCESS {
+			return cvlErrObj.ErrCode
+		}
+		node := c.moveToYangList(yangListName, key)
+		if node == nil {
+			return CVL_FAILURE
+		}
+		//translateToYang adds all default values to the YANG data;
+		//remove the ones not filled yet, their when expressions
+		//may be false
+		removeYangLeaves(node, conditional)
+
+		for _, leafName := range missing {
+			whenExps, exists := conditional[leafName]
+			if !exists {
+				continue
+			}
+			//Leaf level when expressions need the leaf node as context
+			leafNode := c.addYangNode(yangListName, node, leafName, "")
+			whenTrue := c.isWhenExpTrue(node, whenExps)
+			xmlquery.RemoveFromTree(leafNode)
+			if whenTrue {
+				data[defaultFieldName(tInfo, leafName)] = tInfo.dfltLeafVal[leafName]
+				delete(conditional, leafName)
+				changed = true
+			}
+		}
+	}
+
+	return CVL_SUCCESS
+}
+
+// removeYangLeaves removes the child nodes of the YANG list node
+// whose names are in leafNames
+func removeYangLeaves(node *xmlquery.Node, leafNames map[string][]whenExpCtx) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if _, exists := leafNames[child.Data]; exists {
+			xmlquery.RemoveFromTree(child)
+		}
+		child = next
+	}
+}
+
+// stripDefaults removes the fields having default values from the entry data
+func stripDefaults(tInfo *modelTableInfo, data map[string]string) {
+	for leafName, dflt := range tInfo.dfltLeafVal {
+		field := defaultFieldName(tInfo, leafName)
+		if val, exists := data[field]; exists && val == dflt {
+			delete(data, field)
+		}
+	}
+}
+
+// defaultFieldName returns the DB field name of a leaf having default value.
+// Leaf-list field names are suffixed by "@".
+func defaultFieldName(tInfo *modelTableInfo, leafName string) string {
+	if tInfo.dfltLeafList[leafName] {
+		return leafName + "@"
+	}
+	return leafName
+}
+
+// whenExpCtx is a when expression and the node it is attached to
+type whenExpCtx struct {
+	nodeName string
+	whenExp  *whenInfo
+}
+
+// getLeafWhenExps returns the when expressions applicable to a leaf,
+// i.e. the ones at the leaf and at its choice/case/uses statements.
+func getLeafWhenExps(tInfo *modelTableInfo, leafName string) []whenExpCtx {
+	var whenExps []whenExpCtx
+	for nodeName, whenExpArr := range tInfo.whenExpr {
+		for _, whenExp := range whenExpArr {
+			if nodeName == leafName || hasString(whenExp.nodeNames, leafName) {
+				whenExps = append(whenExps, whenExpCtx{nodeName, whenExp})
+			}
+		}
+	}
+	return whenExps
+}
+
+// isWhenExpTrue evaluates the when expressions for the YANG list node
+func (c *CVL) isWhenExpTrue(node *xmlquery.Node, whenExps []whenExpCtx) bool {
+	if len(whenExps) == 0 {
+		return true
+	}
+
+	//Set xpath callback for retreiving dependent data
+	xpath.SetDepDataClbk(c, depDataCb)
+
+	for _, w := range whenExps {
+		ctxNode := node
+		if ctxNode.Data != w.nodeName { //when expression at leaf level
+			for ctxNode = node.FirstChild; ctxNode != nil; ctxNode = ctxNode.NextSibling {
+				if ctxNode.Data == w.nodeName {
+					break
+				}
+			}
+		}
+		if ctxNode == nil {
+			return false
+		}
+
+		//Add data for dependent table in when expression
+		for _, refListName := range w.whenExp.yangListNames {
+			refRedisTableName := getYangListToRedisTbl(refListName)
+			filter := refRedisTableName +
+				modelInfo.tableInfo[refListName].redisKeyDelim + "*"
+			c.addDepYangData([]string{}, filter,
+				strings.Join(modelInfo.tableInfo[refListName].keys, "|"),
+				"true", "", 1) //fetch one entry only
+		}
+
+		if !xmlquery.Eval(c.yv.root, ctxNode, w.whenExp.exprTree) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
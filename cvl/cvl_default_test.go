@@ -0,0 +1,146 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/cvl"
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	type testCase struct {
+		name string
+		mode cvl.DefaultValueMode
+		op   cmn.CVLOperation
+		key  string
+		data map[string]string
+		exp  map[string]string
+	}
+
+	for _, tc := range []testCase{
+		{
+			name: "fill_all",
+			mode: cvl.DefaultValueFill,
+			op:   OP_CREATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"descr": "test"},
+			exp: map[string]string{"descr": "test", "type": "l2", "mtu": "9100",
+				"vlan_mode": "access", "access_vlan": "1", "speed": "auto",
+				"autoneg": "on", "protocols@": "all"},
+		}, {
+			name: "fill_when_false",
+			mode: cvl.DefaultValueFill,
+			op:   OP_CREATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"type": "l3", "mtu": "1500"},
+			exp: map[string]string{"type": "l3", "mtu": "1500", "speed": "auto",
+				"autoneg": "on", "protocols@": "all"},
+		}, {
+			// autoneg and access_vlan depend on the leaves sorted after them
+			name: "fill_when_later_leaf",
+			mode: cvl.DefaultValueFill,
+			op:   OP_CREATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"speed": "10G", "vlan_mode": "trunk"},
+			exp: map[string]string{"speed": "10G", "vlan_mode": "trunk",
+				"type": "l2", "mtu": "9100", "protocols@": "all"},
+		}, {
+			// access_vlan depends on vlan_mode, whose own when is false
+			name: "fill_when_false_chain",
+			mode: cvl.DefaultValueFill,
+			op:   OP_CREATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"type": "l3", "speed": "10G"},
+			exp: map[string]string{"type": "l3", "speed": "10G", "mtu": "9100",
+				"protocols@": "all"},
+		}, {
+			name: "fill_update",
+			mode: cvl.DefaultValueFill,
+			op:   OP_UPDATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"descr": "test"},
+			exp:  map[string]string{"descr": "test"},
+		}, {
+			name: "fill_no_schema",
+			mode: cvl.DefaultValueFill,
+			op:   OP_CREATE,
+			key:  "UNKNOWN_TABLE|entry1",
+			data: map[string]string{"descr": "test"},
+			exp:  map[string]string{"descr": "test"},
+		}, {
+			name: "strip",
+			mode: cvl.DefaultValueStrip,
+			op:   OP_CREATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"type": "l2", "mtu": "1500", "vlan_mode": "access",
+				"protocols@": "all", "descr": "test"},
+			exp: map[string]string{"mtu": "1500", "descr": "test"},
+		}, {
+			name: "strip_update",
+			mode: cvl.DefaultValueStrip,
+			op:   OP_UPDATE,
+			key:  "TEST_DEFAULT|entry1",
+			data: map[string]string{"type": "l2"},
+			exp:  map[string]string{"type": "l2"},
+		},
+	} {
+		t.Run(tc.name, func(tt *testing.T) {
+			c := NewTestSession(tt)
+			cfgData := []CVLEditConfigData{{
+				VType: VALIDATE_ALL,
+				VOp:   tc.op,
+				Key:   tc.key,
+				Data:  tc.data,
+			}}
+			orig := make(map[string]string)
+			for k, v := range tc.data {
+				orig[k] = v
+			}
+
+			res, ret := c.ApplyDefaults(cfgData, tc.mode)
+			if ret != cvl.CVL_SUCCESS {
+				tt.Fatalf("ApplyDefaults() failed: %v", ret)
+			}
+			if !reflect.DeepEqual(res[0].Data, tc.exp) {
+				tt.Errorf("ApplyDefaults() = %v; expected %v", res[0].Data, tc.exp)
+			}
+			if !reflect.DeepEqual(cfgData[0].Data, orig) {
+				tt.Errorf("ApplyDefaults() modified the input data: %v", cfgData[0].Data)
+			}
+		})
+	}
+}
+
+// Filled data must pass the validation
+func TestApplyDefaults_Validate(t *testing.T) {
+	c := NewTestSession(t)
+	cfgData, _ := c.ApplyDefaults([]CVLEditConfigData{{
+		VType: VALIDATE_ALL,
+		VOp:   OP_CREATE,
+		Key:   "TEST_DEFAULT|entry1",
+		Data:  map[string]string{"type": "l3"},
+	}}, cvl.DefaultValueFill)
+
+	res, _ := c.ValidateEditConfig(cfgData)
+	verifyErr(t, res, Success)
+}
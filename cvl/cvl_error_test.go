@@ -160,3 +160,95 @@ func verifyErrorInfoMessage(t *testing.T, errInfo CVLErrorInfo, exp string) {
 		t.Errorf("Received %q", s)
 	}
 }
+
+func verifyValidateEditConfigAll(t *testing.T, maxErrors int, data []CVLEditConfigData, exp ...CVLErrorInfo) {
+	t.Helper()
+	c := NewTestSession(t)
+	c.SetMaxErrors(maxErrors)
+	res, ret := c.ValidateEditConfigAll(data)
+	if len(res) != len(exp) {
+		t.Fatalf("Expected %d errors; received %d: %#v", len(exp), len(res), res)
+	}
+	if len(exp) == 0 && ret != CVL_SUCCESS {
+		t.Errorf("Expected CVL_SUCCESS; received %v", ret)
+	}
+	for i := range exp {
+		verifyErr(t, res[i], exp[i])
+	}
+	if len(exp) != 0 && ret != res[0].ErrCode {
+		t.Errorf("Return code %v does not match first error %v", ret, res[0].ErrCode)
+	}
+}
+
+func invalidVlanIdErr(vlanName, vlanId string) CVLErrorInfo {
+	return CVLErrorInfo{
+		ErrCode:          CVL_SYNTAX_ERROR,
+		TableName:        "VLAN",
+		Keys:             []string{vlanName},
+		Field:            "vlanid",
+		Value:            vlanId,
+		Msg:              invalidValueErrMessage,
+		ConstraintErrMsg: "Vlan ID out of range",
+		ErrAppTag:        "vlanid-invalid",
+	}
+}
+
+func TestValidateEditConfigAll_Syntax(t *testing.T) {
+	cfgData := []CVLEditConfigData{
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN|Vlan711", Data: map[string]string{"vlanid": "7011"}},
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN|Vlan712", Data: map[string]string{"vlanid": "712"}},
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN|Vlan713", Data: map[string]string{"vlanid": "7013"}},
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN|Vlan714", Data: map[string]string{"vlanid": "7014"}},
+	}
+
+	t.Run("default", func(tt *testing.T) {
+		verifyValidateEditConfigAll(tt, 0, cfgData, CVLErrorInfo{ErrCode: CVL_SYNTAX_ERROR})
+	})
+	t.Run("all", func(tt *testing.T) {
+		verifyValidateEditConfigAll(tt, 10, cfgData,
+			invalidVlanIdErr("Vlan711", "7011"),
+			invalidVlanIdErr("Vlan713", "7013"),
+			invalidVlanIdErr("Vlan714", "7014"))
+	})
+	t.Run("limit", func(tt *testing.T) {
+		verifyValidateEditConfigAll(tt, 2, cfgData,
+			invalidVlanIdErr("Vlan711", "7011"),
+			invalidVlanIdErr("Vlan713", "7013"))
+	})
+}
+
+func TestValidateEditConfigAll_Semantic(t *testing.T) {
+	setupTestData(t, map[string]interface{}{
+		"PORT": map[string]interface{}{
+			"Ethernet1": map[string]interface{}{
+				"alias": "hundredGigE1",
+				"lanes": "81,82,83,84",
+				"mtu":   "9100",
+			},
+		},
+		"VLAN": map[string]interface{}{
+			"Vlan721": map[string]interface{}{
+				"vlanid": "721",
+			},
+		},
+	})
+
+	cfgData := []CVLEditConfigData{
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN|Vlan721", Data: map[string]string{"vlanid": "721"}},
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN_MEMBER|Vlan721|Ethernet1", Data: map[string]string{"tagging_mode": "tagged"}},
+		{VType: VALIDATE_ALL, VOp: OP_CREATE, Key: "VLAN_MEMBER|Vlan722|Ethernet1", Data: map[string]string{"tagging_mode": "tagged"}},
+	}
+
+	verifyValidateEditConfigAll(t, 10, cfgData,
+		CVLErrorInfo{
+			ErrCode:   cvl.CVL_SEMANTIC_KEY_ALREADY_EXIST,
+			TableName: "VLAN",
+			Keys:      []string{"Vlan721"},
+		},
+		CVLErrorInfo{
+			ErrCode:   CVL_SEMANTIC_DEPENDENT_DATA_MISSING,
+			TableName: "VLAN_MEMBER",
+			Keys:      []string{"Vlan722", "Ethernet1"},
+			ErrAppTag: "instance-required",
+		})
+}
@@ -2943,11 +2943,122 @@ func TestValidateEditConfig_Delete_Create_Same_Entry_Positive(t *testing.T) {
 }
 
 func TestValidateStartupConfig_Positive(t *testing.T) {
-	cvSess, _ := NewCvlSession()
-	if cvl.CVL_NOT_IMPLEMENTED != cvSess.ValidateStartupConfig("") {
-		t.Errorf("Not implemented yet.")
+	cvSess := NewTestSession(t)
+
+	jsonData := `{
+		"PORT": {
+			"Ethernet1": {
+				"alias": "hundredGigE1",
+				"lanes": "81,82,83,84",
+				"mtu": "9100"
+			},
+			"Ethernet2": {
+				"alias": "hundredGigE2",
+				"lanes": "85,86,87,89",
+				"mtu": "9100"
+			}
+		},
+		"VLAN": {
+			"Vlan800": {
+				"members": ["Ethernet1", "Ethernet2"],
+				"vlanid": "800"
+			}
+		},
+		"VLAN_MEMBER": {
+			"Vlan800|Ethernet1": {
+				"tagging_mode": "tagged"
+			},
+			"Vlan800|Ethernet2": {
+				"tagging_mode": "untagged"
+			}
+		},
+		"UNKNOWN_TABLE": {
+			"key1": {
+				"field1": "value1"
+			}
+		}
+	}`
+
+	errs, ret := cvSess.ValidateStartupConfig(jsonData)
+	if ret != cvl.CVL_SUCCESS || len(errs) != 0 {
+		t.Errorf("Config Validation failed; ret = %v, errors = %v", ret, errs)
+	}
+}
+
+func TestValidateStartupConfig_Negative(t *testing.T) {
+	// Port present in Redis should not satisfy references in the config
+	setupTestData(t, map[string]interface{}{
+		"PORT": map[string]interface{}{
+			"Ethernet8": map[string]interface{}{
+				"alias": "hundredGigE8",
+				"lanes": "91,92,93,94",
+				"mtu":   "9100",
+			},
+		},
+	})
+
+	cvSess := NewTestSession(t)
+
+	jsonData := `{
+		"PORT": {
+			"Ethernet1": {
+				"alias": "hundredGigE1",
+				"lanes": "81,82,83,84",
+				"mtu": "9100"
+			}
+		},
+		"VLAN": {
+			"Vlan800": {
+				"vlanid": "800"
+			},
+			"Vlan801": {
+				"vlanid": "5000"
+			}
+		},
+		"VLAN_MEMBER": {
+			"Vlan800|Ethernet1": {
+				"tagging_mode": "tagged"
+			},
+			"Vlan800|Ethernet8": {
+				"tagging_mode": "tagged"
+			},
+			"Vlan802|Ethernet1": {
+				"tagging_mode": "tagged"
+			}
+		}
+	}`
+
+	errs, ret := cvSess.ValidateStartupConfig(jsonData)
+	if ret == cvl.CVL_SUCCESS {
+		t.Fatalf("Config Validation succeeded unexpectedly")
+	}
+
+	expErrs := map[string]bool{
+		"VLAN|Vlan801":                  false,
+		"VLAN_MEMBER|Vlan800|Ethernet8": false,
+		"VLAN_MEMBER|Vlan802|Ethernet1": false,
+	}
+	for _, e := range errs {
+		errKey := e.TableName + "|" + strings.Join(e.Keys, "|")
+		if _, ok := expErrs[errKey]; !ok {
+			t.Errorf("Unexpected error %v", e)
+		}
+		expErrs[errKey] = true
+	}
+	for errKey, found := range expErrs {
+		if !found {
+			t.Errorf("No error reported for %s", errKey)
+		}
+	}
+}
+
+func TestValidateStartupConfig_InvalidJson(t *testing.T) {
+	cvSess := NewTestSession(t)
+
+	errs, ret := cvSess.ValidateStartupConfig(`{"PORT": `)
+	if ret != cvl.CVL_SYNTAX_ERROR || len(errs) != 1 {
+		t.Errorf("Expected one syntax error; ret = %v, errors = %v", ret, errs)
 	}
-	cvl.ValidationSessClose(cvSess)
 }
 
 func TestValidateIncrementalConfig_Positive(t *testing.T) {
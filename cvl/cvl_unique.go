@@ -0,0 +1,259 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+// checkUniqueConstraints checks the YANG 'unique' constraints of a list for
+// an entry being created or updated. Values of the entry, after applying the
+// edits in request cache, are compared with other entries of the list in db
+// and request cache.
+func (c *CVL) checkUniqueConstraints(yangListName, tbl, key string) CVLErrorInfo {
+	tInfo := modelInfo.tableInfo[yangListName]
+	if tInfo == nil || len(tInfo.unique) == 0 {
+		return CVLErrorInfo{ErrCode: CVL_SUCCESS}
+	}
+
+	entry := c.getNetEntry(tbl, key)
+	if entry == nil {
+		return CVLErrorInfo{ErrCode: CVL_SUCCESS}
+	}
+
+	keyComps := splitKeyComponents(tbl, key)
+	for _, leafNames := range tInfo.unique {
+		values, ok := getUniqueValues(tInfo, leafNames, keyComps, entry)
+		if !ok {
+			//Constraint does not apply if any of the leaf is not present
+			continue
+		}
+
+		if otherKey := c.findUniqueConflict(tInfo, yangListName, tbl, key, leafNames, values); otherKey != "" {
+			return newUniqueError(tbl, key, otherKey, leafNames, values)
+		}
+	}
+
+	return CVLErrorInfo{ErrCode: CVL_SUCCESS}
+}
+
+// findUniqueConflict returns the key of another entry of the list having the
+// same values for the unique leaves; empty string if there are none.
+func (c *CVL) findUniqueConflict(tInfo *modelTableInfo, yangListName, tbl, key string,
+	leafNames, values []string) string {
+
+	candidates := make(map[string]interface{})
+
+	//Entries in db having the same values
+	s := cmn.Search{
+		Pattern:   tbl + tInfo.redisKeyDelim + "*",
+		Predicate: uniquePredicate(tInfo, leafNames, values),
+		KeyNames:  tInfo.keys,
+	}
+	if data, err := c.dbAccess.Lookup(s).Result(); err == nil && len(data) != 0 {
+		var tblData map[string]map[string]interface{}
+		if err = json.Unmarshal([]byte(data), &tblData); err != nil {
+			CVL_LOG(WARNING, "Could not parse %s entries for unique check, err=%v", tbl, err)
+		}
+		for k := range tblData[tbl] {
+			candidates[k] = nil
+		}
+	}
+
+	//Entries created or modified in the request
+	for k := range c.requestCache[tbl] {
+		candidates[k] = nil
+	}
+
+	delete(candidates, key)
+
+	for _, otherKey := range sortedMapKeys(candidates) {
+		if getRedisTblToYangList(tbl, otherKey) != yangListName {
+			continue
+		}
+
+		//Entry may have been modified or deleted in the request
+		entry := c.getNetEntry(tbl, otherKey)
+		if entry == nil {
+			continue
+		}
+
+		otherValues, ok := getUniqueValues(tInfo, leafNames, splitKeyComponents(tbl, otherKey), entry)
+		if ok && strings.Join(otherValues, "\x00") == strings.Join(values, "\x00") {
+			return otherKey
+		}
+	}
+
+	return ""
+}
+
+// getNetEntry returns the fields of an entry after applying the edits in
+// request cache on the db data. Returns nil if the entry does not exist.
+func (c *CVL) getNetEntry(tbl, key string) map[string]string {
+	var entry map[string]string
+	cfgDataArr := c.requestCache[tbl][key]
+
+	if len(cfgDataArr) == 0 || cfgDataArr[0].ReqData.VOp != cmn.OP_CREATE {
+		redisKey := tbl + modelInfo.tableInfo[getRedisTblToYangList(tbl, key)].redisKeyDelim + key
+		dbData, err := c.dbAccess.HGetAll(redisKey).Result()
+		if err == nil && len(dbData) != 0 {
+			entry = dbData
+		}
+	}
+
+	for _, cfgData := range cfgDataArr {
+		switch cfgData.ReqData.VOp {
+		case cmn.OP_CREATE:
+			entry = make(map[string]string, len(cfgData.ReqData.Data))
+			mergeMap(entry, cfgData.ReqData.Data)
+		case cmn.OP_UPDATE:
+			if entry == nil {
+				entry = make(map[string]string, len(cfgData.ReqData.Data))
+			}
+			mergeMap(entry, cfgData.ReqData.Data)
+		case cmn.OP_DELETE:
+			if len(cfgData.ReqData.Data) == 0 {
+				entry = nil
+			}
+			for field := range cfgData.ReqData.Data {
+				delete(entry, field)
+			}
+		}
+	}
+
+	return entry
+}
+
+// getUniqueValues returns the values of the unique leaves of an entry.
+// Default values are used for the leaves not present in the entry.
+// Returns false if any of the leaves does not have a value.
+func getUniqueValues(tInfo *modelTableInfo, leafNames, keyComps []string,
+	entry map[string]string) ([]string, bool) {
+
+	values := make([]string, 0, len(leafNames))
+	for _, leafName := range leafNames {
+		if idx := keyIndex(tInfo, leafName); idx >= 0 {
+			if idx >= len(keyComps) {
+				return nil, false
+			}
+			values = append(values, keyComps[idx])
+		} else if val, exists := entry[leafName]; exists {
+			values = append(values, val)
+		} else if val, exists := tInfo.dfltLeafVal[leafName]; exists {
+			values = append(values, val)
+		} else {
+			return nil, false
+		}
+	}
+
+	return values, true
+}
+
+func keyIndex(tInfo *modelTableInfo, leafName string) int {
+	for idx, keyName := range tInfo.keys {
+		if keyName == leafName {
+			return idx
+		}
+	}
+	return -1
+}
+
+// uniquePredicate returns the lua predicate to search db entries having the
+// given values for the unique leaves.
+func uniquePredicate(tInfo *modelTableInfo, leafNames, values []string) string {
+	conds := make([]string, len(leafNames))
+	for i, leafName := range leafNames {
+		val := luaQuote(values[i])
+		if keyIndex(tInfo, leafName) >= 0 {
+			conds[i] = fmt.Sprintf("k['%s'] == %s", leafName, val)
+		} else if dflt, exists := tInfo.dfltLeafVal[leafName]; exists && dflt == values[i] {
+			conds[i] = fmt.Sprintf("(h['%s'] == %s or h['%s'] == nil)", leafName, val, leafName)
+		} else {
+			conds[i] = fmt.Sprintf("h['%s'] == %s", leafName, val)
+		}
+	}
+
+	return "return (" + strings.Join(conds, " and ") + ")"
+}
+
+// luaQuote returns a single quoted lua string literal for the value.
+func luaQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
+
+func newUniqueError(tbl, key, otherKey string, leafNames, values []string) CVLErrorInfo {
+	cvlErrObj := CVLErrorInfo{
+		ErrCode:       CVL_SEMANTIC_ERROR,
+		TableName:     tbl,
+		Keys:          splitKeyComponents(tbl, key),
+		Field:         leafNames[0],
+		Value:         values[0],
+		CVLErrDetails: cvlErrorMap[CVL_SEMANTIC_ERROR],
+		ErrAppTag:     "data-not-unique",
+		Msg: fmt.Sprintf("Unique constraint violated, %s %s already used by %s",
+			strings.Join(leafNames, ","), strings.Join(values, ","), otherKey),
+	}
+	cvlErrObj.ConstraintErrMsg = cvlErrObj.Msg
+	return cvlErrObj
+}
+
+// checkStartupUniqueConstraints checks the YANG 'unique' constraints on the
+// entries of a table in startup config. Entries which already failed are
+// ignored; an error is reported for every entry whose values are used by
+// an earlier entry.
+func checkStartupUniqueConstraints(tbl string, tblData map[string]interface{},
+	failedEntries map[string]bool) []CVLErrorInfo {
+
+	var errs []CVLErrorInfo
+	used := make(map[string]string) //unique values => first key
+
+	for _, key := range sortedMapKeys(tblData) {
+		yangListName := getRedisTblToYangList(tbl, key)
+		tInfo := modelInfo.tableInfo[yangListName]
+		fields, ok := tblData[key].(map[string]interface{})
+		if tInfo == nil || !ok || failedEntries[tbl+"|"+key] {
+			continue
+		}
+
+		entry := cmn.ToRedisFields(fields)
+		keyComps := splitKeyComponents(tbl, key)
+		for idx, leafNames := range tInfo.unique {
+			values, ok := getUniqueValues(tInfo, leafNames, keyComps, entry)
+			if !ok {
+				continue
+			}
+
+			id := fmt.Sprintf("%s\x00%d\x00%s", yangListName, idx, strings.Join(values, "\x00"))
+			if otherKey, exists := used[id]; exists {
+				errs = append(errs, newUniqueError(tbl, key, otherKey, leafNames, values))
+				break
+			}
+			used[id] = key
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,202 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package cvl_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/cvl"
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+)
+
+func uniqueErr(key, field, value string) CVLErrorInfo {
+	return CVLErrorInfo{
+		ErrCode:   CVL_SEMANTIC_ERROR,
+		TableName: strings.Split(key, "|")[0],
+		Keys:      strings.Split(key, "|")[1:],
+		Field:     field,
+		Value:     value,
+		ErrAppTag: "data-not-unique",
+	}
+}
+
+// Test the unique constraint validations
+func TestValidateEditConfig_Unique(t *testing.T) {
+
+	setupTestData(t, map[string]interface{}{
+		"TEST_UNIQUE": map[string]interface{}{
+			"entry1": map[string]interface{}{
+				"priority": "10",
+				"vlan":     "Vlan10",
+			},
+			"entry2": map[string]interface{}{
+				"priority": "20",
+				"vlan":     "Vlan10",
+				"mode":     "manual",
+			},
+		}})
+
+	newKey := "TEST_UNIQUE|new"
+
+	t.Run("create_unique", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   newKey,
+			Data:  map[string]string{"priority": "30", "vlan": "Vlan10", "mode": "static"},
+		}})
+		verifyErr(tt, res, Success)
+	})
+
+	t.Run("create_dup_priority", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   newKey,
+			Data:  map[string]string{"priority": "20"},
+		}})
+		verifyErr(tt, res, uniqueErr(newKey, "priority", "20"))
+	})
+
+	t.Run("create_dup_default", func(tt *testing.T) {
+		// entry1 has default mode "auto"
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   newKey,
+			Data:  map[string]string{"vlan": "Vlan10", "mode": "auto"},
+		}})
+		verifyErr(tt, res, uniqueErr(newKey, "vlan", "Vlan10"))
+	})
+
+	t.Run("update_dup_priority", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_UPDATE,
+			Key:   "TEST_UNIQUE|entry2",
+			Data:  map[string]string{"priority": "10"},
+		}})
+		verifyErr(tt, res, uniqueErr("TEST_UNIQUE|entry2", "priority", "10"))
+	})
+
+	t.Run("update_same_entry", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_UPDATE,
+			Key:   "TEST_UNIQUE|entry1",
+			Data:  map[string]string{"priority": "10", "descr": "no change"},
+		}})
+		verifyErr(tt, res, Success)
+	})
+
+	t.Run("swap_priority", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_UPDATE,
+			Key:   "TEST_UNIQUE|entry1",
+			Data:  map[string]string{"priority": "20"},
+		}, {
+			VType: VALIDATE_ALL,
+			VOp:   OP_UPDATE,
+			Key:   "TEST_UNIQUE|entry2",
+			Data:  map[string]string{"priority": "10"},
+		}})
+		verifyErr(tt, res, Success)
+	})
+
+	t.Run("reuse_deleted", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_DELETE,
+			Key:   "TEST_UNIQUE|entry1",
+			Data:  map[string]string{},
+		}, {
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   newKey,
+			Data:  map[string]string{"priority": "10", "vlan": "Vlan10"},
+		}})
+		verifyErr(tt, res, Success)
+	})
+
+	t.Run("dup_in_request", func(tt *testing.T) {
+		c := NewTestSession(tt)
+		res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   "TEST_UNIQUE|new1",
+			Data:  map[string]string{"priority": "50"},
+		}, {
+			VType: VALIDATE_ALL,
+			VOp:   OP_CREATE,
+			Key:   "TEST_UNIQUE|new2",
+			Data:  map[string]string{"priority": "50"},
+		}})
+		verifyErr(tt, res, uniqueErr("TEST_UNIQUE|new1", "priority", "50"))
+	})
+}
+
+// Test unique constraint validations with in-memory db data
+func TestValidateEditConfig_Unique_MapDB(t *testing.T) {
+	db, err := cmn.NewMapDBAccess(map[string]interface{}{
+		"TEST_UNIQUE": map[string]interface{}{
+			"entry1": map[string]interface{}{"priority": "10", "vlan": "Vlan10"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewMapDBAccess() failed: %v", err)
+	}
+
+	c, _ := cvl.ValidationSessOpen(db)
+	defer cvl.ValidationSessClose(c)
+
+	res, _ := c.ValidateEditConfig([]CVLEditConfigData{{
+		VType: VALIDATE_ALL,
+		VOp:   OP_CREATE,
+		Key:   "TEST_UNIQUE|new",
+		Data:  map[string]string{"vlan": "Vlan10"},
+	}})
+	verifyErr(t, res, uniqueErr("TEST_UNIQUE|new", "vlan", "Vlan10"))
+}
+
+func TestValidateStartupConfig_Unique(t *testing.T) {
+	c := NewTestSession(t)
+	errs, _ := c.ValidateStartupConfig(`{
+		"TEST_UNIQUE": {
+			"entry1": {"priority": "10", "vlan": "Vlan10"},
+			"entry2": {"priority": "10", "vlan": "Vlan10", "mode": "manual"},
+			"entry3": {"priority": "30", "vlan": "Vlan10", "mode": "auto"}
+		}
+	}`)
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors; found %v", errs)
+	}
+	verifyErr(t, errs[0], uniqueErr("TEST_UNIQUE|entry2", "priority", "10"))
+	verifyErr(t, errs[1], uniqueErr("TEST_UNIQUE|entry3", "vlan", "Vlan10"))
+}
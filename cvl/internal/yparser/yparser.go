@@ -46,8 +46,10 @@ int lyd_data_validate(struct lyd_node **node, int options, struct ly_ctx *ctx)
 {
 	int ret = -1;
 
-	//Check mandatory elements as it is skipped for LYD_OPT_EDIT
-	ret = lyd_check_mandatory_tree(*node, ctx, NULL, 0, LYD_OPT_CONFIG | LYD_OPT_NOEXTDEPS);
+	//Check mandatory elements as it is skipped for LYD_OPT_EDIT and LYD_OPT_GET.
+	//State data (LYD_OPT_GET) can have mandatory nodes under config false.
+	int mand_options = (options & LYD_OPT_GET) ? LYD_OPT_DATA : LYD_OPT_CONFIG;
+	ret = lyd_check_mandatory_tree(*node, ctx, NULL, 0, mand_options | LYD_OPT_NOEXTDEPS);
 
 	if (ret != 0)
 	{
@@ -264,12 +266,14 @@ type YParserListInfo struct {
 	LeafRef         map[string][]string //for storing all leafrefs for a leaf in a table,
 	//multiple leafref possible for union
 	DfltLeafVal      map[string]string //Default value for leaf/leaf-list
+	DfltLeafList     map[string]bool   //Leaf-lists having default value
 	XpathExpr        map[string][]*XpathExpression
 	CustValidation   map[string][]string
 	WhenExpr         map[string][]*WhenExpression //multiple when expression for choice/case etc
 	MandatoryNodes   map[string]bool
-	DependentOnTable string //for table on which it is dependent
-	Key              string //Static key, value comes from sonic-extension:tbl-key
+	DependentOnTable string     //for table on which it is dependent
+	Key              string     //Static key, value comes from sonic-extension:tbl-key
+	Unique           [][]string //Leaf names of each 'unique' statement
 }
 
 type YParserLeafValue struct {
@@ -281,6 +285,7 @@ type YParser struct {
 	//ctx *YParserCtx    //Parser context
 	root      *YParserNode //Top evel root for validation
 	operation string       //Edit operation
+	stateData bool         //Data is not config; allows config false nodes
 }
 
 // YParserError YParser Error Structure
@@ -512,6 +517,12 @@ func (yp *YParser) SetOperation(op string) YParserError {
 	return YParserError{ErrCode: YP_SUCCESS}
 }
 
+// SetStateData sets whether the data being validated is state data, i.e
+// data of a non-config DB, which can have config false nodes.
+func (yp *YParser) SetStateData(stateData bool) {
+	yp.stateData = stateData
+}
+
 // createTempDepData merge depdata and data to create temp data. used in syntax, semantic and custom validation
 func (yp *YParser) createTempDepData(dataTmp *(*C.struct_lyd_node), depData *YParserNode) YParserError {
 
@@ -534,8 +545,12 @@ func (yp *YParser) ValidateSyntax(data, depData *YParserNode) YParserError {
 		}
 	}
 
-	//Just validate syntax
-	if C.lyd_data_validate(&dataTmp, C.LYD_OPT_EDIT|C.LYD_OPT_NOEXTDEPS, (*C.struct_ly_ctx)(ypCtx)) != 0 {
+	//Just validate syntax; LYD_OPT_GET is the LYD_OPT_EDIT equivalent for state data
+	options := C.int(C.LYD_OPT_EDIT | C.LYD_OPT_NOEXTDEPS)
+	if yp.stateData {
+		options = C.int(C.LYD_OPT_GET | C.LYD_OPT_NOEXTDEPS)
+	}
+	if C.lyd_data_validate(&dataTmp, options, (*C.struct_ly_ctx)(ypCtx)) != 0 {
 		if IsTraceAllowed(TRACE_ONERROR) {
 			strData := yp.NodeDump((*YParserNode)(dataTmp))
 			TRACE_LOG(TRACE_ONERROR, "Failed to validate Syntax, data = %v", strData)
@@ -803,6 +818,7 @@ func getModelChildInfo(l *YParserListInfo, node *C.struct_lys_node,
 
 					//Remove last ','
 					l.DfltLeafVal[leafName] = tmpValStr
+					l.DfltLeafList[leafName] = true
 				}
 
 				// leaf-list with min-elements > 0 should be treated as a mandatory node.
@@ -880,6 +896,18 @@ func getModelChildInfo(l *YParserListInfo, node *C.struct_lys_node,
 	}
 }
 
+// trimNodeIdPrefixes removes module prefixes from a descendant schema node id.
+// E.g, "acl:PRIORITY" => "PRIORITY"
+func trimNodeIdPrefixes(nodeId string) string {
+	steps := strings.Split(nodeId, "/")
+	for i, step := range steps {
+		if n := strings.IndexByte(step, ':'); n >= 0 {
+			steps[i] = step[n+1:]
+		}
+	}
+	return strings.Join(steps, "/")
+}
+
 // GetModelListInfo Get model info for YANG list and its subtree
 func GetModelListInfo(module *YParserModule) []*YParserListInfo {
 	var list []*YParserListInfo
@@ -926,6 +954,7 @@ func GetModelListInfo(module *YParserModule) []*YParserListInfo {
 			l.CustValidation = make(map[string][]string)
 			l.WhenExpr = make(map[string][]*WhenExpression)
 			l.DfltLeafVal = make(map[string]string)
+			l.DfltLeafList = make(map[string]bool)
 			l.MandatoryNodes = make(map[string]bool)
 
 			//Add keys
@@ -952,6 +981,19 @@ func GetModelListInfo(module *YParserModule) []*YParserListInfo {
 				}
 			}
 
+			//Check for unique statements
+			if slist.unique_size > 0 {
+				uniqs := unsafe.Slice(slist.unique, int(slist.unique_size))
+				for _, uniq := range uniqs {
+					exprs := unsafe.Slice(uniq.expr, int(uniq.expr_size))
+					leafNames := make([]string, 0, len(exprs))
+					for _, expr := range exprs {
+						leafNames = append(leafNames, trimNodeIdPrefixes(C.GoString(expr)))
+					}
+					l.Unique = append(l.Unique, leafNames)
+				}
+			}
+
 			//Check for custom extension
 			if slist.ext_size > 0 {
 				exts := (*[10]*C.struct_lys_ext_instance)(unsafe.Pointer(slist.ext))
@@ -34,4 +34,4 @@ require (
 	google.golang.org/protobuf v1.21.0 // indirect
 )
 
-go 1.19
+go 1.21
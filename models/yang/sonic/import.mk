@@ -7,4 +7,14 @@
 #
 SONICYANG_IMPORTS += sonic-sflow.yang
 SONICYANG_IMPORTS += sonic-interface.yang
-SONICYANG_IMPORTS += sonic-port.yang
\ No newline at end of file
+SONICYANG_IMPORTS += sonic-port.yang
+SONICYANG_IMPORTS += sonic-loopback-interface.yang
+SONICYANG_IMPORTS += sonic-vlan.yang
+SONICYANG_IMPORTS += sonic-vlan-sub-interface.yang
+SONICYANG_IMPORTS += sonic-device_metadata.yang
+SONICYANG_IMPORTS += sonic-dns.yang
+SONICYANG_IMPORTS += sonic-ntp.yang
+SONICYANG_IMPORTS += sonic-syslog.yang
+SONICYANG_IMPORTS += sonic-system-aaa.yang
+SONICYANG_IMPORTS += sonic-system-tacacs.yang
+SONICYANG_IMPORTS += sonic-system-radius.yang
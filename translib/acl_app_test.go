@@ -113,6 +113,36 @@ func Test_AclApp_SingleRuleOperations(t *testing.T) {
 	t.Run("Verify_One_Acl_Delete", processGetRequest(aclUrl, "", true))
 }
 
+// This will test creating a Rule through its config container, below acl-entry
+func Test_AclApp_CreateRuleBelowAclEntry(t *testing.T) {
+	aclUrl := "/openconfig-acl:acl/acl-sets/acl-set[name=MyACL6][type=ACL_IPV4]"
+	ruleConfigUrl := aclUrl + "/acl-entries/acl-entry[sequence-id=9]/config"
+
+	t.Run("Create_One_Acl_Without_Rule", processSetRequest(aclUrl, oneAclCreateMyAcl6JsonRequest, "POST", false))
+	t.Run("Create_Rule_Through_Config", processSetRequest(ruleConfigUrl, requestRuleConfigPatchJson, "PATCH", false))
+	t.Run("Verify_Rule_Priority_And_IpType", verifyAclRuleFields("MyACL6_ACL_IPV4", "RULE_9",
+		map[string]string{"PRIORITY": "65527", "IP_TYPE": "IPV4ANY"}))
+
+	t.Run("Delete_One_Acl", processDeleteRequest(aclUrl))
+	t.Run("Verify_One_Acl_Delete", processGetRequest(aclUrl, "", true))
+}
+
+func verifyAclRuleFields(aclKey, ruleName string, fields map[string]string) func(*testing.T) {
+	return func(t *testing.T) {
+		d := getConfigDb()
+		defer d.DeleteDB()
+		entry, err := d.GetEntry(&db.TableSpec{Name: "ACL_RULE"}, db.Key{Comp: []string{aclKey, ruleName}})
+		if err != nil {
+			t.Fatalf("Error %v reading ACL_RULE %s|%s", err, aclKey, ruleName)
+		}
+		for f, v := range fields {
+			if got := entry.Get(f); got != v {
+				t.Errorf("ACL_RULE %s|%s: %s = %q, want %q", aclKey, ruleName, f, got, v)
+			}
+		}
+	}
+}
+
 // This will test PUT (Replace) operation by  Replacing multiple Rules with one Rule in an Acl
 func Test_AclApp_ReplaceMultipleRulesWithOneRule(t *testing.T) {
 	url := "/openconfig-acl:acl/acl-sets/acl-set"
@@ -270,7 +300,7 @@ func Test_AclApp_IPv6AclAndRule(t *testing.T) {
 }
 
 func Test_AclApp_L2AclAndRule(t *testing.T) {
-	t.Skip("AclApp does not support L2 ACLs")
+	t.Skip("L2 ACL rules are not supported")
 
 	aclUrl := "/openconfig-acl:acl/acl-sets/acl-set[name=MyACL2][type=ACL_L2]"
 	ruleUrl := "/openconfig-acl:acl/acl-sets/acl-set[name=MyACL2][type=ACL_L2]/acl-entries/acl-entry[sequence-id=2]"
@@ -480,9 +510,13 @@ func ruleTableNInfo(namePattern, typePattern, seqPattern, fieldsJson string) *no
 	}
 }
 
+// Bindings are sampled from ACL_TABLE by the acl_port_bindings_xfmr subtree
+// transformer. AclApp did not map the bindings path to any DB (dbno MaxDB).
 func bindingTableNInfo() *notificationAppInfo {
 	return &notificationAppInfo{
-		dbno:                db.MaxDB, // app is not mapping bindings path to any DB
+		dbno:                db.ConfigDB,
+		table:               &db.TableSpec{Name: "ACL_TABLE"},
+		key:                 db.NewKey("*"),
 		isOnChangeSupported: false,
 		pType:               Sample,
 	}
@@ -502,6 +536,8 @@ var oneAclCreateWithRulesJsonRequest string = "{\"acl-set\":[{ \"name\": \"MyACL
 var oneAclCreateWithRulesJsonResponse string = "{\"openconfig-acl:acl-set\":[{\"acl-entries\":{\"acl-entry\":[{\"actions\":{\"config\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"},\"state\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"}},\"config\":{\"sequence-id\":1},\"ipv4\":{\"config\":{\"destination-address\":\"21.1.1.1/32\",\"dscp\":1,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.1/32\"},\"state\":{\"destination-address\":\"21.1.1.1/32\",\"dscp\":1,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.1/32\"}},\"sequence-id\":1,\"state\":{\"matched-octets\":\"0\",\"matched-packets\":\"0\",\"sequence-id\":1},\"transport\":{\"config\":{\"destination-port\":201,\"source-port\":101},\"state\":{\"destination-port\":201,\"source-port\":101}}},{\"actions\":{\"config\":{\"forwarding-action\":\"openconfig-acl:DROP\"},\"state\":{\"forwarding-action\":\"openconfig-acl:DROP\"}},\"config\":{\"sequence-id\":2},\"ipv4\":{\"config\":{\"destination-address\":\"21.1.1.2/32\",\"dscp\":2,\"protocol\":\"openconfig-packet-match-types:IP_UDP\",\"source-address\":\"11.1.1.2/32\"},\"state\":{\"destination-address\":\"21.1.1.2/32\",\"dscp\":2,\"protocol\":\"openconfig-packet-match-types:IP_UDP\",\"source-address\":\"11.1.1.2/32\"}},\"sequence-id\":2,\"state\":{\"matched-octets\":\"0\",\"matched-packets\":\"0\",\"sequence-id\":2},\"transport\":{\"config\":{\"destination-port\":202,\"source-port\":102},\"state\":{\"destination-port\":202,\"source-port\":102}}},{\"actions\":{\"config\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"},\"state\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"}},\"config\":{\"sequence-id\":3},\"ipv4\":{\"config\":{\"destination-address\":\"21.1.1.3/32\",\"dscp\":3,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.3/32\"},\"state\":{\"destination-address\":\"21.1.1.3/32\",\"dscp\":3,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.3/32\"}},\"sequence-id\":3,\"state\":{\"matched-octets\":\"0\",\"matched-packets\":\"0\",\"sequence-id\":3},\"transport\":{\"config\":{\"destination-port\":203,\"source-port\":103},\"state\":{\"destination-port\":203,\"source-port\":103}}},{\"actions\":{\"config\":{\"forwarding-action\":\"openconfig-acl:DROP\"},\"state\":{\"forwarding-action\":\"openconfig-acl:DROP\"}},\"config\":{\"sequence-id\":4},\"ipv4\":{\"config\":{\"destination-address\":\"21.1.1.4/32\",\"dscp\":4,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.4/32\"},\"state\":{\"destination-address\":\"21.1.1.4/32\",\"dscp\":4,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.4/32\"}},\"sequence-id\":4,\"state\":{\"matched-octets\":\"0\",\"matched-packets\":\"0\",\"sequence-id\":4},\"transport\":{\"config\":{\"destination-port\":204,\"source-port\":104},\"state\":{\"destination-port\":204,\"source-port\":104}}},{\"actions\":{\"config\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"},\"state\":{\"forwarding-action\":\"openconfig-acl:ACCEPT\"}},\"config\":{\"sequence-id\":5},\"ipv4\":{\"config\":{\"destination-address\":\"21.1.1.5/32\",\"dscp\":5,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.5/32\"},\"state\":{\"destination-address\":\"21.1.1.5/32\",\"dscp\":5,\"protocol\":\"openconfig-packet-match-types:IP_TCP\",\"source-address\":\"11.1.1.5/32\"}},\"sequence-id\":5,\"state\":{\"matched-octets\":\"0\",\"matched-packets\":\"0\",\"sequence-id\":5},\"transport\":{\"config\":{\"destination-port\":205,\"source-port\":105},\"state\":{\"destination-port\":205,\"source-port\":105}}}]},\"config\":{\"description\":\"Description for MyACL3\",\"name\":\"MyACL3\",\"type\":\"openconfig-acl:ACL_IPV4\"},\"name\":\"MyACL3\",\"state\":{\"description\":\"Description for MyACL3\",\"name\":\"MyACL3\",\"type\":\"openconfig-acl:ACL_IPV4\"},\"type\":\"openconfig-acl:ACL_IPV4\"}]}"
 
 var oneAclCreateJsonRequest string = "{\"config\": {\"name\": \"MyACL5\",\"type\": \"ACL_IPV4\",\"description\": \"Description for MyACL5\"}}"
+var oneAclCreateMyAcl6JsonRequest string = "{\"config\": {\"name\": \"MyACL6\",\"type\": \"ACL_IPV4\",\"description\": \"Description for MyACL6\"}}"
+var requestRuleConfigPatchJson string = "{\"openconfig-acl:config\": {\"sequence-id\": 9,\"description\": \"Description for MyACL6 Rule Seq 9\"}}"
 var oneAclCreateJsonResponse string = "{\"openconfig-acl:acl-set\":[{\"config\":{\"description\":\"Description for MyACL5\",\"name\":\"MyACL5\",\"type\":\"openconfig-acl:ACL_IPV4\"},\"name\":\"MyACL5\",\"state\":{\"description\":\"Description for MyACL5\",\"name\":\"MyACL5\",\"type\":\"openconfig-acl:ACL_IPV4\"},\"type\":\"openconfig-acl:ACL_IPV4\"}]}"
 
 var aclDescrUpdateJson string = "{\"openconfig-acl:description\":\"Verifying ACL Description Update\"}"
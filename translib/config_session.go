@@ -0,0 +1,92 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package translib
+
+import (
+	"github.com/Azure/sonic-mgmt-common/translib/cs"
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+// ConfigSession identifies a config session (see package cs) by its token,
+// or by its name when the token is empty. Writes of a request having one
+// go to the candidate config of the session; reads see the candidate config.
+// Requests without one work on the running config.
+type ConfigSession struct {
+	Name  string
+	Token string
+}
+
+// IsEmpty returns true if no config session is identified.
+func (s ConfigSession) IsEmpty() bool {
+	return len(s.Name) == 0 && len(s.Token) == 0
+}
+
+// getConfigSession returns the config session of a request. An empty
+// session, whose GetConfigDB() points to the running config, is returned
+// for requests without session. The session must belong to the user.
+func getConfigSession(s ConfigSession, user UserRoles, authEnabled bool) (cs.Session, error) {
+	var opts []cs.GetSessionOpts
+	if len(s.Token) == 0 && len(s.Name) != 0 {
+		opts = append(opts, cs.GSOname{})
+	}
+
+	sess, err := cs.GetSession(s.Name, s.Token, user.Name, user.Roles, 0, opts...)
+	if err != nil || s.IsEmpty() {
+		return sess, err
+	}
+
+	if !sess.IsConfigSession() {
+		if len(s.Token) != 0 {
+			return sess, cs.CsStatusInvalidSession{Tag: cs.ErrTagTokenNotFound}
+		}
+		return sess, cs.CsStatusInvalidSession{Tag: cs.ErrTagNameNotFound}
+	}
+	if authEnabled && sess.Username() != user.Name {
+		return sess, cs.CsStatusInvalidSession{Tag: cs.ErrTagInvalidUser}
+	}
+
+	return sess, nil
+}
+
+// getAllDbsForSession is getAllDbs for a request in config session sess;
+// its ConfigDB is the candidate config DB of the session. The returned
+// function closes the DBs.
+func getAllDbsForSession(sess *cs.Session, opts ...func(*db.Options)) ([db.MaxDB]*db.DB, func(), error) {
+	dbs, err := getAllDbs(opts...)
+	if err != nil || !sess.IsConfigSession() {
+		return dbs, func() { closeAllDbs(dbs[:]) }, err
+	}
+
+	dbs[db.ConfigDB].DeleteDB()
+	ccDB, _, cleanup, err := sess.GetConfigDB(nil)
+	if err != nil {
+		dbs[db.ConfigDB] = nil
+		closeAllDbs(dbs[:])
+		return dbs, func() {}, err
+	}
+
+	dbs[db.ConfigDB] = ccDB
+	return dbs, func() {
+		// Candidate config DB stays open for the session
+		dbs[db.ConfigDB] = nil
+		cleanup()
+		closeAllDbs(dbs[:])
+	}, nil
+}
@@ -0,0 +1,104 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package translib
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/translib/cs"
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+// startConfigSession starts an unnamed config session for the test and
+// aborts it at the end.
+func startConfigSession(t *testing.T, user UserRoles) cs.Session {
+	t.Helper()
+	sess, err := cs.GetSession("", "", user.Name, user.Roles, int32(os.Getpid()), cs.GSOname{})
+	if err != nil {
+		t.Fatalf("cs.GetSession() failed: %v", err)
+	}
+	if _, ok, status := sess.StartOrResume(int32(os.Getpid())); !ok {
+		t.Fatalf("StartOrResume() failed: %v", status.Status())
+	}
+	t.Cleanup(func() { sess.Abort() })
+	return sess
+}
+
+func TestGetConfigSession(t *testing.T) {
+	user := UserRoles{Name: "admin", Roles: []string{"admin"}}
+
+	t.Run("no_session", func(t *testing.T) {
+		sess, err := getConfigSession(ConfigSession{}, user, true)
+		if err != nil || sess.IsConfigSession() {
+			t.Fatalf("getConfigSession() = %v, %v; expected empty session", sess, err)
+		}
+	})
+	t.Run("bad_token", func(t *testing.T) {
+		_, err := getConfigSession(ConfigSession{Token: "bad"}, user, true)
+		if _, ok := err.(cs.CsStatusInvalidSession); !ok {
+			t.Fatalf("getConfigSession() error = %v; expected CsStatusInvalidSession", err)
+		}
+	})
+
+	sess := startConfigSession(t, user)
+
+	t.Run("token", func(t *testing.T) {
+		s, err := getConfigSession(ConfigSession{Token: sess.Token()}, user, true)
+		if err != nil || s.Token() != sess.Token() {
+			t.Fatalf("getConfigSession() = %v, %v", s, err)
+		}
+	})
+	t.Run("other_user", func(t *testing.T) {
+		other := UserRoles{Name: "guest", Roles: []string{"admin"}}
+		_, err := getConfigSession(ConfigSession{Token: sess.Token()}, other, true)
+		if e, ok := err.(cs.CsStatusInvalidSession); !ok || e.Tag != cs.ErrTagInvalidUser {
+			t.Fatalf("getConfigSession() error = %v; expected %v", err, cs.ErrTagInvalidUser)
+		}
+	})
+}
+
+// Changes in a config session must be visible in the session only
+func TestConfigSessionSetGet(t *testing.T) {
+	user := UserRoles{Name: "admin", Roles: []string{"admin"}}
+	aclUrl := "/openconfig-acl:acl/acl-sets/acl-set[name=MyACL5][type=ACL_IPV4]"
+	sess := startConfigSession(t, user)
+	session := ConfigSession{Token: sess.Token()}
+
+	_, err := Create(SetRequest{Path: aclUrl, Payload: []byte(oneAclCreateJsonRequest),
+		User: user, Session: session})
+	if err != nil {
+		t.Fatalf("Create() in session failed: %v", err)
+	}
+
+	d := getConfigDb()
+	defer d.DeleteDB()
+	if _, err := d.GetEntry(&db.TableSpec{Name: "ACL_TABLE"}, db.Key{Comp: []string{"MyACL5"}}); err == nil {
+		t.Fatalf("Session changes written to running config")
+	}
+
+	t.Run("get_session", func(t *testing.T) {
+		verifyGet(t, GetRequest{Path: aclUrl, User: user, Session: session},
+			oneAclCreateJsonResponse, false)
+	})
+	t.Run("get_running", func(t *testing.T) {
+		verifyGet(t, GetRequest{Path: aclUrl, User: user}, "", true)
+	})
+}
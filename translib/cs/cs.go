@@ -24,6 +24,7 @@ package cs
 import (
 	"fmt"
 	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -71,7 +72,7 @@ type configSession struct {
 	resumeTime time.Time
 	exitTime   time.Time
 
-	// lastActiveTime could be used for (Future) ConfigSession Idle Timeout
+	// lastActiveTime is used for the idle timeout (cs_expiry.go)
 	lastActiveTime time.Time
 
 	//Commit timer
@@ -87,13 +88,22 @@ type configSession struct {
 
 	// channel to end timer routine.
 	commitCh chan<- bool
+
+	// concurrent session does not hold the ConfigDB lock while editing.
+	// Conflicts with the running config are checked at commit.
+	concurrent bool
 }
 
 var csMutex sync.Mutex
 
-// uCS is the unnamed Config Session (the only one supported in first cut)
+// uCS is the unnamed Config Session. It holds the ConfigDB lock for its
+// whole lifetime.
 var uCS *configSession
 
+// csNamed are the named Config Sessions. They are concurrent sessions,
+// which take the ConfigDB lock only to commit.
+var csNamed = make(map[string]*configSession)
+
 var csTokenCtr uint32
 
 func (cs configSession) String() string {
@@ -126,21 +136,67 @@ func (cs *configSession) IsPidActive() bool {
 	return true
 }
 
+// getCS returns the session by name; uCS for the unnamed session.
+// csMutex must be held by caller.
+func getCS(name string) *configSession {
+	if len(name) == 0 {
+		return uCS
+	}
+	return csNamed[name]
+}
+
+// setCS adds, or removes (nil cs), the session by name.
+// csMutex must be held by caller.
+func setCS(name string, cs *configSession) {
+	if len(name) == 0 {
+		uCS = cs
+	} else if cs == nil {
+		delete(csNamed, name)
+	} else {
+		csNamed[name] = cs
+	}
+}
+
+// allCS returns all the sessions. csMutex must be held by caller.
+func allCS() []*configSession {
+	all := make([]*configSession, 0, len(csNamed)+1)
+	if uCS != nil {
+		all = append(all, uCS)
+	}
+	for _, cs := range csNamed {
+		all = append(all, cs)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].name < all[j].name })
+	return all
+}
+
+// lookupCS returns the session cs is a copy of. csMutex must be held by caller.
+func (cs *configSession) lookupCS() *configSession {
+	if cs == nil {
+		return nil
+	}
+	return getCS(cs.name)
+}
+
 func (cs *configSession) UpdateLastActiveTime() {
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	if !(cs == nil || uCS == nil) {
-		uCS.lastActiveTime = time.Now()
+	if c := cs.lookupCS(); c != nil {
+		c.lastActiveTime = time.Now()
 	}
 }
 
 func (cs *configSession) StartCommitTimer(timeout int) *time.Timer {
+	return cs.startCommitTimer(time.Duration(timeout) * time.Second)
+}
+
+func (cs *configSession) startCommitTimer(timeout time.Duration) *time.Timer {
 	csMutex.Lock()
 	defer csMutex.Unlock()
-	if !(cs == nil || uCS == nil) {
-		uCS.commitTimer = time.NewTimer(time.Duration(timeout) * time.Second)
-		return uCS.commitTimer
+	if c := cs.lookupCS(); c != nil {
+		c.commitTimer = time.NewTimer(timeout)
+		return c.commitTimer
 	}
 	return nil
 }
@@ -148,24 +204,24 @@ func (cs *configSession) StartCommitTimer(timeout int) *time.Timer {
 func (cs *configSession) SetRollbackCfg(cfg string) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
-	if !(cs == nil || uCS == nil) {
-		uCS.rollbackCfg = cfg
+	if c := cs.lookupCS(); c != nil {
+		c.rollbackCfg = cfg
 	}
 }
 
 func (cs *configSession) SetCommitState(state configSessionState) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
-	if !(cs == nil || uCS == nil) {
-		uCS.commitState = state
+	if c := cs.lookupCS(); c != nil {
+		c.commitState = state
 	}
 }
 
 func (cs *configSession) SetCommitCh(ch chan<- bool) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
-	if !(cs == nil || uCS == nil) {
-		uCS.commitCh = ch
+	if c := cs.lookupCS(); c != nil {
+		c.commitCh = ch
 	}
 }
 
@@ -175,14 +231,16 @@ func newCS(name string, username string, roles []string, pid int32) (*configSess
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	// Does the uCS exist already?
-	if uCS != nil {
+	// Does the session exist already?
+	if getCS(name) != nil {
 		return nil, tlerr.TranslibBusy{}
 	}
 
+	concurrent := len(name) != 0
 	ccDB, err := db.NewDB(db.Options{DBNo: db.ConfigDB,
-		IsSession: true,
-		TxCmdsLim: ccDbTxCmdsLim})
+		IsSession:        true,
+		TxCmdsLim:        ccDbTxCmdsLim,
+		TrackBaseVersion: concurrent})
 	if err != nil {
 		glog.Errorf("newCS: db.NewDB err %s", err)
 		return nil, err
@@ -196,28 +254,33 @@ func newCS(name string, username string, roles []string, pid int32) (*configSess
 	token := fmt.Sprintf("%d-%d", time.Now().Unix(),
 		atomic.AddUint32(&csTokenCtr, 1))
 
-	if err = db.ConfigDBTryLock(token); err != nil {
-		glog.Errorf("newCS: db.ConfigDBTryLock err %s", err)
-		csAbortTx(ccDB)
-		ccDB.DeleteDB()
-		return nil, err
+	if !concurrent {
+		if err = db.ConfigDBTryLock(token); err != nil {
+			glog.Errorf("newCS: db.ConfigDBTryLock err %s", err)
+			csAbortTx(ccDB)
+			ccDB.DeleteDB()
+			return nil, err
+		}
 	}
 
-	uCS = &configSession{
-		name:     name,
-		token:    token,
-		state:    cs_STATE_ACTIVE,
-		username: username,
-		roles:    roles,
-		pid:      pid,
-		ccDB:     ccDB,
+	cs := &configSession{
+		name:       name,
+		token:      token,
+		state:      cs_STATE_ACTIVE,
+		username:   username,
+		roles:      roles,
+		pid:        pid,
+		ccDB:       ccDB,
+		concurrent: concurrent,
 	}
 
-	uCS.startTime = time.Now()
-	uCS.lastActiveTime = uCS.startTime
+	cs.startTime = time.Now()
+	cs.lastActiveTime = cs.startTime
+	setCS(name, cs)
 
-	glog.Infof("newCS[%s]: %s %s %v %d", token, name, username, roles, pid)
-	return uCS, nil
+	glog.Infof("newCS[%s]: %s %s %v %d concurrent %v", token, name, username,
+		roles, pid, concurrent)
+	return cs, nil
 }
 
 func resumeCS(name string, roles []string, pid int32) (*configSession, error) {
@@ -226,20 +289,21 @@ func resumeCS(name string, roles []string, pid int32) (*configSession, error) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	if uCS == nil || uCS.state != cs_STATE_SUSPENDED {
-		return uCS, tlerr.TranslibBusy{}
+	cs := getCS(name)
+	if cs == nil || cs.state != cs_STATE_SUSPENDED {
+		return cs, tlerr.TranslibBusy{}
 	}
 
-	uCS.state = cs_STATE_ACTIVE
+	cs.state = cs_STATE_ACTIVE
 
 	// Update the Pid, and Roles
-	uCS.roles = roles
-	uCS.pid = pid
-	uCS.resumeTime = time.Now()
-	uCS.lastActiveTime = uCS.resumeTime
+	cs.roles = roles
+	cs.pid = pid
+	cs.resumeTime = time.Now()
+	cs.lastActiveTime = cs.resumeTime
 
-	glog.Infof("resumeCS[%s]: %s %s %v", uCS.token, name, roles, pid)
-	return uCS, nil
+	glog.Infof("resumeCS[%s]: %s %s %v", cs.token, name, roles, pid)
+	return cs, nil
 }
 
 func suspendCS(name string) (*configSession, error) {
@@ -248,17 +312,18 @@ func suspendCS(name string) (*configSession, error) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	if uCS == nil || uCS.state != cs_STATE_ACTIVE {
-		return uCS, tlerr.TranslibBusy{}
+	cs := getCS(name)
+	if cs == nil || cs.state != cs_STATE_ACTIVE {
+		return cs, tlerr.TranslibBusy{}
 	}
 
-	uCS.state = cs_STATE_SUSPENDED
-	uCS.pid = 0
-	uCS.exitTime = time.Now()
-	uCS.lastActiveTime = uCS.exitTime
+	cs.state = cs_STATE_SUSPENDED
+	cs.pid = 0
+	cs.exitTime = time.Now()
+	cs.lastActiveTime = cs.exitTime
 
-	glog.Infof("suspendCS[%s]: %s", uCS.token, name)
-	return uCS, nil
+	glog.Infof("suspendCS[%s]: %s", cs.token, name)
+	return cs, nil
 }
 
 // commitCS commits the Transaction. A primary error, and a list of secondary
@@ -274,36 +339,45 @@ func commitCS(name string, label string, isConfirmNeeded bool) (error, error, er
 	var err, errSc, errSh error
 	var token string
 
-	if uCS == nil || uCS.state != cs_STATE_ACTIVE {
+	cs := getCS(name)
+	if cs == nil || cs.state != cs_STATE_ACTIVE {
 		err = tlerr.TranslibBusy{}
-	} else if err = csCommitTx(uCS.ccDB); err != nil {
+	} else if cs.concurrent && cs.commitState != cs_STATE_CONFIRM_TIMER {
+		err = lockForCommit(cs)
+	}
+
+	if err != nil {
+		glog.Errorf("commitCS: err %s", err)
+	} else if err = csCommitTx(cs.ccDB); err != nil {
 		// On Commit Failure, keep the Session active so the admin can
 		// review their changes. They can abort the session after review.
 		glog.Errorf("commitCS: csCommitTx err %s", err)
+		if txErr, ok := err.(db.TxConflictError); ok {
+			err = newCsStatusConflict(txErr.Conflicts)
+		}
+		if cs.concurrent {
+			db.ConfigDBUnlock(cs.token)
+		}
 	} else {
 
-		uCS.commitTime = time.Now()
-		token = uCS.token
+		cs.commitTime = time.Now()
+		token = cs.token
 		if isConfirmNeeded {
-			uCS.commitState = cs_STATE_CONFIRM_TIMER
-			uCS.ccDB.Opts.IsCommitted = true
+			cs.commitState = cs_STATE_CONFIRM_TIMER
+			cs.ccDB.Opts.IsCommitted = true
 		} else {
 			// Cp History
-			errSh = createCpHistory(label)
-			uCS.ccDB.DeleteDB()
-			uCS.state = cs_STATE_None
+			errSh = createCpHistory(cs, label)
+			cs.ccDB.DeleteDB()
+			cs.state = cs_STATE_None
 			// Db Unlock
-			if errSc = db.ConfigDBUnlock(uCS.token); errSc != nil {
+			if errSc = db.ConfigDBUnlock(cs.token); errSc != nil {
 				glog.Warningf("commitCS: db.ConfigDBUnlock errSc %+v", errSc)
 			}
-			uCS = nil
+			setCS(name, nil)
 		}
 	}
 
-	if err == nil && !isConfirmNeeded {
-		uCS = nil
-	}
-
 	glog.Infof("commitCS[%s]: end", token)
 
 	return err, errSc, errSh
@@ -317,10 +391,18 @@ func deleteCS(name string) (error, error) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	if uCS == nil {
+	if getCS(name) == nil {
 		return tlerr.TranslibBusy{}, nil
 	}
-	switch uCS.state {
+
+	return deleteUCS(name)
+}
+
+// deleteUCS aborts the Transaction of the session by name. csMutex must be
+// held by caller.
+func deleteUCS(name string) (error, error) {
+	cs := getCS(name)
+	switch cs.state {
 	case cs_STATE_ACTIVE, cs_STATE_SUSPENDED:
 		break
 	default:
@@ -329,55 +411,83 @@ func deleteCS(name string) (error, error) {
 
 	//Skip AbortTx when commit is in commit timer state.
 	//CommitTx is done while moving to commit timer state.
-	var err error
-	if uCS.commitState != cs_STATE_CONFIRM_TIMER {
-		err = csAbortTx(uCS.ccDB)
+	var err, errU error
+	if cs.commitState != cs_STATE_CONFIRM_TIMER {
+		err = csAbortTx(cs.ccDB)
 		if err != nil {
 			glog.Errorf("deleteCS: csAbortTx err %s", err)
 		}
 	}
 
-	errU := db.ConfigDBUnlock(uCS.token)
-	if errU != nil {
-		glog.Errorf("deleteCS: db.ConfigDBUnlock err %s", errU)
+	// The concurrent session holds the lock only in the confirm timer
+	if !cs.concurrent || cs.commitState == cs_STATE_CONFIRM_TIMER {
+		errU = db.ConfigDBUnlock(cs.token)
+		if errU != nil {
+			glog.Errorf("deleteCS: db.ConfigDBUnlock err %s", errU)
+		}
 	}
 
-	uCS.ccDB.DeleteDB()
-	uCS.state = cs_STATE_None
+	cs.ccDB.DeleteDB()
+	cs.state = cs_STATE_None
 
-	glog.Infof("deleteCS[%s]: %s err %s errU %s", uCS.token, name, err, errU)
+	glog.Infof("deleteCS[%s]: %s err %s errU %s", cs.token, name, err, errU)
 
-	uCS = nil
+	setCS(name, nil)
 
 	return err, errU
 }
 
-func createCpHistory(label string) error {
+func createCpHistory(cs *configSession, label string) error {
 	// Cp History
 	var errSh error
-	if errSh = CreateCpHistEntry(label, uCS.token, uCS.username,
-		"commit", uCS.commitTime.UnixNano()); errSh != nil {
+	if errSh = CreateCpHistEntry(label, cs.token, cs.username,
+		"commit", cs.commitTime.UnixNano()); errSh != nil {
 		glog.Warningf("commitCS: CreateCpHistEntry errSh %+v", errSh)
 	}
 	return errSh
 }
 
-func cleanCS() error {
+func cleanCS(name string) error {
 
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
-	uCS.ccDB.DeleteDB()
-	uCS.state = cs_STATE_None
+	cs := getCS(name)
+	if cs == nil {
+		return nil
+	}
+
+	cs.ccDB.DeleteDB()
+	cs.state = cs_STATE_None
 	// Db Unlock
 	var errSc error
-	if errSc = db.ConfigDBUnlock(uCS.token); errSc != nil {
+	if errSc = db.ConfigDBUnlock(cs.token); errSc != nil {
 		glog.Warningf("commitCS: db.ConfigDBUnlock errSc %+v", errSc)
 	}
-	uCS = nil
+	setCS(name, nil)
 	return errSc
 }
 
+// lockForCommit takes the ConfigDB lock for the commit of a concurrent
+// session, and checks the keys the session read or wrote for changes in
+// the running config since. The lock is released on conflicts.
+func lockForCommit(cs *configSession) error {
+	if err := db.ConfigDBTryLock(cs.token); err != nil {
+		glog.Errorf("lockForCommit[%s]: db.ConfigDBTryLock err %s", cs.token, err)
+		return err
+	}
+
+	conflicts, err := cs.ccDB.CheckTxConflicts()
+	if err == nil && len(conflicts) != 0 {
+		err = newCsStatusConflict(conflicts)
+	}
+	if err != nil {
+		glog.Warningf("lockForCommit[%s]: err %s", cs.token, err)
+		db.ConfigDBUnlock(cs.token)
+	}
+	return err
+}
+
 func DebugGetCSDB() *db.DB {
 	csMutex.Lock()
 	defer csMutex.Unlock()
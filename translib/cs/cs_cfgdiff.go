@@ -0,0 +1,315 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Config Diff
+
+package cs
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/Azure/sonic-mgmt-common/translib/transformer"
+	"github.com/golang/glog"
+)
+
+// cfgKeySeparator is the table and key separator of CONFIG_DB, and of the
+// config_db.json format checkpoints are saved in.
+const cfgKeySeparator = "|"
+
+// cfgData is a full CONFIG_DB snapshot: table -> key -> redis fields.
+type cfgData map[string]map[string]map[string]string
+
+// CfgChangeOp is the kind of change of a CONFIG_DB entry
+type CfgChangeOp int
+
+const (
+	CfgChangeCreate CfgChangeOp = iota + 1
+	CfgChangeUpdate
+	CfgChangeDelete
+)
+
+func (op CfgChangeOp) String() string {
+	switch op {
+	case CfgChangeCreate:
+		return "create"
+	case CfgChangeUpdate:
+		return "update"
+	case CfgChangeDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// CpRunning names the running CONFIG_DB in DiffCheckpoints
+const CpRunning = "running"
+
+// CfgChange is the change of one CONFIG_DB entry. Old is nil for a create,
+// and New is nil for a delete. YangPaths holds the OpenConfig paths of the
+// entry (create, delete) or of the changed leaves (update), when requested.
+type CfgChange struct {
+	Table     string
+	Key       string
+	Op        CfgChangeOp
+	Old       map[string]string
+	New       map[string]string
+	YangPaths []string
+}
+
+// ChangedFields returns the sorted names of the fields that differ
+// between Old and New.
+func (c CfgChange) ChangedFields() []string {
+	var fields []string
+	for f, v := range c.Old {
+		if nv, ok := c.New[f]; !ok || nv != v {
+			fields = append(fields, f)
+		}
+	}
+	for f := range c.New {
+		if _, ok := c.Old[f]; !ok {
+			fields = append(fields, f)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// DiffCheckpoints returns the changes of the config from checkpoint "from"
+// to checkpoint "to". The checkpoints are given by label or commit ID, or
+// CpRunning for the running config. If yangPaths is set, the changes are
+// also translated to OpenConfig YANG paths.
+func DiffCheckpoints(from, to string, yangPaths bool) ([]CfgChange, error) {
+	glog.Infof("DiffCheckpoints: %s -> %s", from, to)
+
+	fromCfg, err := loadCfg(from)
+	if err != nil {
+		return nil, err
+	}
+	toCfg, err := loadCfg(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffCfg(fromCfg, toCfg)
+	if yangPaths && len(changes) != 0 {
+		dbs := openReadOnlyDbs()
+		defer closeDbs(dbs[:])
+		xlateCfgChanges(changes, PendingFormatOpenConfig, dbs)
+	}
+	return changes, nil
+}
+
+// loadCfg reads the checkpoint, or the running config
+func loadCfg(labelOrId string) (cfgData, error) {
+	if labelOrId == CpRunning {
+		d, err := db.NewDB(db.Options{DBNo: db.ConfigDB,
+			IsWriteDisabled: true})
+		if err != nil {
+			return nil, err
+		}
+		defer d.DeleteDB()
+		return readRunningCfg(d)
+	}
+
+	ent, ok := findCpHistEntry(labelOrId)
+	if !ok {
+		return nil, tlerr.NotFound("checkpoint %s not found", labelOrId)
+	}
+	return loadCheckpointCfg(ent)
+}
+
+// openReadOnlyDbs opens all the DBs for the transformer; the DBs which
+// fail to open are left nil.
+func openReadOnlyDbs() [db.MaxDB]*db.DB {
+	var dbs [db.MaxDB]*db.DB
+	for dbNum := db.DBNum(0); dbNum < db.MaxDB; dbNum++ {
+		if len(dbNum.Name()) == 0 {
+			continue
+		}
+		d, err := db.NewDB(db.Options{DBNo: dbNum, IsWriteDisabled: true})
+		if err != nil {
+			glog.Warningf("openReadOnlyDbs: %v: err %v", dbNum, err)
+			continue
+		}
+		dbs[dbNum] = d
+	}
+	return dbs
+}
+
+func closeDbs(dbs []*db.DB) {
+	for _, d := range dbs {
+		if d != nil {
+			d.DeleteDB()
+		}
+	}
+}
+
+// xlateCfgChanges fills the YangPaths of the changes in the format
+func xlateCfgChanges(changes []CfgChange, format PendingFormat,
+	dbs [db.MaxDB]*db.DB) {
+
+	for i, c := range changes {
+		key := db.Key{Comp: strings.Split(c.Key, cfgKeySeparator)}
+		var fields []string
+		if c.Op == CfgChangeUpdate {
+			fields = c.ChangedFields()
+		}
+
+		switch format {
+		case PendingFormatOpenConfig:
+			entry := db.Value{Field: c.New}
+			if c.Op == CfgChangeDelete {
+				entry.Field = c.Old
+			}
+			changes[i].YangPaths = transformer.XlateDbEntryToYangPaths(
+				c.Table, key, &entry, fields, dbs)
+		case PendingFormatSonic:
+			changes[i].YangPaths = transformer.XlateDbEntryToSonicYangPaths(
+				c.Table, key, fields)
+		}
+	}
+}
+
+// loadCheckpointCfg reads the config_db.json format checkpoint from the
+// store, and verifies it against the SHA-256 in the history entry.
+func loadCheckpointCfg(ent CpHistEntry) (cfgData, error) {
+	name := ent.cpFileName()
+	bData, err := cpStore.LoadConfig(name)
+	if err != nil {
+		glog.Errorf("loadCheckpointCfg: %s: err %v", name, err)
+		return nil, err
+	}
+	if len(ent.Sha256) != 0 {
+		if sum := newCpFileInfo(bData).Sha256; sum != ent.Sha256 {
+			glog.Errorf("loadCheckpointCfg: %s: sha256 %s; expected %s",
+				name, sum, ent.Sha256)
+			return nil, tlerr.New("checkpoint %s integrity check failed", name)
+		}
+	}
+	return parseCfgJSON(bData)
+}
+
+func parseCfgJSON(bData []byte) (cfgData, error) {
+	var jData map[string]map[string]map[string]interface{}
+	if err := json.Unmarshal(bData, &jData); err != nil {
+		glog.Errorf("parseCfgJSON: err %v", err)
+		return nil, err
+	}
+
+	cfg := make(cfgData, len(jData))
+	for table, entries := range jData {
+		cfg[table] = make(map[string]map[string]string, len(entries))
+		for key, fields := range entries {
+			cfg[table][key] = cmn.ToRedisFields(fields)
+		}
+	}
+	return cfg, nil
+}
+
+// toJSON returns the config in config_db.json format
+func (cfg cfgData) toJSON() ([]byte, error) {
+	jData := make(map[string]map[string]map[string]interface{}, len(cfg))
+	for table, entries := range cfg {
+		jData[table] = make(map[string]map[string]interface{}, len(entries))
+		for key, fields := range entries {
+			jFields := make(map[string]interface{}, len(fields))
+			for name, val := range fields {
+				if name == "NULL" {
+					continue
+				} else if strings.HasSuffix(name, "@") {
+					var list []string
+					if len(val) != 0 {
+						list = strings.Split(val, ",")
+					}
+					jFields[strings.TrimSuffix(name, "@")] = list
+				} else {
+					jFields[name] = val
+				}
+			}
+			jData[table][key] = jFields
+		}
+	}
+	return json.MarshalIndent(jData, "", "    ")
+}
+
+// readRunningCfg reads all of the CONFIG_DB through d.
+func readRunningCfg(d *db.DB) (cfgData, error) {
+	tables, err := d.GetConfig(nil, &db.GetConfigOptions{AllowWritable: true})
+	if err != nil {
+		glog.Errorf("readRunningCfg: GetConfig err %v", err)
+		return nil, err
+	}
+
+	cfg := make(cfgData, len(tables))
+	for ts, table := range tables {
+		keys, _ := table.GetKeys()
+		entries := make(map[string]map[string]string, len(keys))
+		for _, key := range keys {
+			value, err := table.GetEntry(key)
+			if err != nil {
+				return nil, err
+			}
+			entries[strings.Join(key.Comp, cfgKeySeparator)] = value.Field
+		}
+		cfg[ts.Name] = entries
+	}
+	return cfg, nil
+}
+
+// diffCfg returns the changes which turn from into to, sorted by table
+// and key.
+func diffCfg(from, to cfgData) []CfgChange {
+	var changes []CfgChange
+
+	for table, entries := range from {
+		for key, oldFields := range entries {
+			newFields, ok := to[table][key]
+			if !ok {
+				changes = append(changes, CfgChange{Table: table, Key: key,
+					Op: CfgChangeDelete, Old: oldFields})
+			} else if !reflect.DeepEqual(oldFields, newFields) {
+				changes = append(changes, CfgChange{Table: table, Key: key,
+					Op: CfgChangeUpdate, Old: oldFields, New: newFields})
+			}
+		}
+	}
+
+	for table, entries := range to {
+		for key, newFields := range entries {
+			if _, ok := from[table][key]; !ok {
+				changes = append(changes, CfgChange{Table: table, Key: key,
+					Op: CfgChangeCreate, New: newFields})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Table != changes[j].Table {
+			return changes[i].Table < changes[j].Table
+		}
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
@@ -0,0 +1,89 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Config Diff Test
+
+package cs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCfgJSON(t *testing.T) {
+	cfg, err := parseCfgJSON([]byte(`{
+		"VLAN": {"Vlan10": {"vlanid": "10", "members": ["Ethernet0", "Ethernet4"]}},
+		"VRF": {"Vrf1": {}}
+	}`))
+	if err != nil {
+		t.Fatalf("parseCfgJSON() fails e: %v", err)
+	}
+	exp := cfgData{
+		"VLAN": {"Vlan10": {"vlanid": "10", "members@": "Ethernet0,Ethernet4"}},
+		"VRF":  {"Vrf1": {"NULL": "NULL"}},
+	}
+	if !reflect.DeepEqual(cfg, exp) {
+		t.Errorf("parseCfgJSON() = %v; expected %v", cfg, exp)
+	}
+}
+
+func TestDiffCfg(t *testing.T) {
+	from := cfgData{
+		"PORT": {
+			"Ethernet0": {"mtu": "9100"},
+			"Ethernet4": {"mtu": "9100"},
+		},
+		"VLAN": {"Vlan10": {"vlanid": "10"}},
+	}
+	to := cfgData{
+		"PORT": {
+			"Ethernet0": {"mtu": "1500"},
+			"Ethernet4": {"mtu": "9100"},
+		},
+		"ACL_TABLE": {"MyACL": {"type": "L3"}},
+	}
+
+	exp := []CfgChange{
+		{Table: "ACL_TABLE", Key: "MyACL", Op: CfgChangeCreate,
+			New: map[string]string{"type": "L3"}},
+		{Table: "PORT", Key: "Ethernet0", Op: CfgChangeUpdate,
+			Old: map[string]string{"mtu": "9100"},
+			New: map[string]string{"mtu": "1500"}},
+		{Table: "VLAN", Key: "Vlan10", Op: CfgChangeDelete,
+			Old: map[string]string{"vlanid": "10"}},
+	}
+	if changes := diffCfg(from, to); !reflect.DeepEqual(changes, exp) {
+		t.Errorf("diffCfg() = %+v; expected %+v", changes, exp)
+	}
+
+	if changes := diffCfg(to, to); len(changes) != 0 {
+		t.Errorf("diffCfg() on same config = %+v; expected none", changes)
+	}
+}
+
+func TestCfgChangeChangedFields(t *testing.T) {
+	c := CfgChange{Op: CfgChangeUpdate,
+		Old: map[string]string{"mtu": "9100", "speed": "100000", "fec": "rs"},
+		New: map[string]string{"mtu": "1500", "speed": "100000", "alias": "eth0"},
+	}
+	exp := []string{"alias", "fec", "mtu"}
+	if fields := c.ChangedFields(); !reflect.DeepEqual(fields, exp) {
+		t.Errorf("ChangedFields() = %v; expected %v", fields, exp)
+	}
+}
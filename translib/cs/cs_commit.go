@@ -65,7 +65,7 @@ func processCommitConfirm(sess *Session, label string) (bool, CsStatus) {
 		sess.configSession.commitTimer.Stop()
 		sess.configSession.commitCh <- true
 	}
-	if errSh := createCpHistory(label); errSh != nil {
+	if errSh := createCpHistory(&sess.configSession, label); errSh != nil {
 		status = CsStatusCommitFailure{Err: errSh}
 	} else {
 		status = CsStatusCommitSuccess{}
@@ -73,14 +73,17 @@ func processCommitConfirm(sess *Session, label string) (bool, CsStatus) {
 	}
 
 	//Clean up to unlock db
-	cleanCS()
+	cleanCS(sess.name)
+	if err := deletePendingCommit(); err != nil {
+		glog.Warningf("Commit:[%s]: deletePendingCommit err %v", sess.token, err)
+	}
 
 	return success, status
 }
 
-func processCommitTimer(sess *Session, timeout int, rollbackCfg string) (bool, CsStatus) {
+func processCommitTimer(sess *Session, timeout time.Duration, rollbackCfg string) (bool, CsStatus) {
 
-	commitTimer := sess.StartCommitTimer(timeout)
+	commitTimer := sess.startCommitTimer(timeout)
 	if commitTimer == nil {
 		glog.Errorf("Commit:[%s]: Timer start failure. ", sess.token)
 		err := tlerr.New("Failed to start commit confirm timer")
@@ -109,7 +112,10 @@ func processCommitTimer(sess *Session, timeout int, rollbackCfg string) (bool, C
 			}
 
 			//Clean up
-			cleanCS()
+			cleanCS(sess.name)
+			if err := deletePendingCommit(); err != nil {
+				glog.Warningf("Commit:[%s]: deletePendingCommit err %v", sess.token, err)
+			}
 		}
 
 	}()
@@ -141,6 +147,9 @@ func abortSessionCommit(sess *Session) error {
 			sess.token, err.Error())
 		return errors.New("Configuration reload failure on commit timeout rollback")
 	}
+	if err := deletePendingCommit(); err != nil {
+		glog.Warningf("Commit:[%s]: deletePendingCommit err %v", sess.token, err)
+	}
 	return nil
 }
 
@@ -152,6 +161,9 @@ func (sess *Session) Commit(label string,
 	var status CsStatus
 
 	if sess.IsConfigSession() {
+		if err := validateCpLabel(label); err != nil {
+			return false, CsStatusCommitFailure{Err: err}
+		}
 		if isCpLabelExist(label) {
 			errSh := tlerr.InvalidArgs("label: %s already exist. Choose another label", label)
 			status = CsStatusCommitFailure{Err: errSh}
@@ -188,11 +200,32 @@ func (sess *Session) Commit(label string,
 					break
 				}
 				sess.SetRollbackCfg(rollbackCfg)
+
+				// Save the state for restoring after a process restart,
+				// before the commit; it is not rolled back otherwise.
+				pc := pendingCommit{name: sess.name, token: sess.token,
+					username: sess.username, roles: sess.roles,
+					rollbackCfg: rollbackCfg, commitTime: time.Now(),
+					deadline: time.Now().Add(time.Duration(timeout) * time.Second)}
+				if err = savePendingCommit(pc); err != nil {
+					glog.Errorf("Commit:[%s]: savePendingCommit err %v", sess.token, err)
+					status = CsStatusCommitFailure{Err: err}
+					break
+				}
 			}
 			var errSc, errSh error
 			if err, errSc, errSh = commitCS(sess.name, label, commitConfirmTimer); err != nil {
 				success = false
-				status = CsStatusCommitFailure{Err: err}
+				if commitConfirmTimer {
+					if errD := deletePendingCommit(); errD != nil {
+						glog.Warningf("Commit:[%s]: deletePendingCommit err %v", sess.token, errD)
+					}
+				}
+				if conflict, ok := err.(CsStatusConflict); ok {
+					status = conflict
+				} else {
+					status = CsStatusCommitFailure{Err: err}
+				}
 				break
 			}
 			success = true
@@ -200,7 +233,8 @@ func (sess *Session) Commit(label string,
 			// If session timer given start the timer and return success.
 
 			if commitConfirmTimer {
-				success, status = processCommitTimer(sess, timeout, rollbackCfg)
+				confirmTimeout := time.Duration(timeout) * time.Second
+				success, status = processCommitTimer(sess, confirmTimeout, rollbackCfg)
 
 			} else {
 				if (errSc == nil) && (errSh == nil) {
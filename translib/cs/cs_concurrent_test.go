@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Concurrent Sessions Test
+
+package cs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func createInCS(t *testing.T, cs *configSession, ts *db.TableSpec, key db.Key,
+	value db.Value) {
+
+	sess := Session{configSession: *cs}
+	d := cs.ccDB
+	e := sess.StartTx(d, nil, nil)
+	if e == nil {
+		if e = d.CreateEntry(ts, key, value); e == nil {
+			e = sess.CommitTx(d)
+		} else {
+			sess.AbortTx(d)
+		}
+	}
+	if e != nil {
+		t.Fatalf("CreateEntry() in session %s fails e: %v", cs.name, e)
+	}
+}
+
+func TestCSConcurrentConflict(t *testing.T) {
+	ts := &db.TableSpec{Name: "ACL_TABLE"}
+	key := db.Key{Comp: []string{"CsConcACL"}}
+	value := db.Value{Field: map[string]string{"type": "L3", "stage": "INGRESS"}}
+
+	t.Cleanup(func() {
+		if d, e := db.NewDB(db.Options{DBNo: db.ConfigDB}); e == nil {
+			d.DeleteEntry(ts, key)
+			d.DeleteDB()
+		}
+	})
+
+	cs1, e := newCS("cs1", user, uR, pid)
+	if e != nil {
+		t.Fatalf("newCS(cs1) fails e: %v", e)
+	}
+	t.Cleanup(func() { deleteCS("cs1") })
+	cs2, e := newCS("cs2", user, uR, pid)
+	if e != nil {
+		t.Fatalf("newCS(cs2) fails e: %v", e)
+	}
+	t.Cleanup(func() { deleteCS("cs2") })
+
+	t.Logf("Named sessions do not lock the ConfigDB")
+	if e = db.ConfigDBTryLock("test"); e != nil {
+		t.Fatalf("ConfigDBTryLock() with sessions fails e: %v", e)
+	}
+	db.ConfigDBUnlock("test")
+
+	createInCS(t, cs1, ts, key, value)
+	createInCS(t, cs2, ts, key, value)
+
+	if e, _, _ = commitCS("cs1", "", false); e != nil {
+		t.Fatalf("commitCS(cs1) fails e: %v", e)
+	}
+
+	e, _, _ = commitCS("cs2", "", false)
+	conflict, ok := e.(CsStatusConflict)
+	if !ok {
+		t.Fatalf("commitCS(cs2) = %v; expected CsStatusConflict", e)
+	}
+	if exp := []string{"ACL_TABLE|CsConcACL"}; !reflect.DeepEqual(conflict.Keys, exp) {
+		t.Errorf("commitCS(cs2) conflict keys %v; expected %v", conflict.Keys, exp)
+	}
+
+	t.Logf("Session stays for review after the conflict; lock is released")
+	if sess, e := GetSession("cs2", "", user, uR, pid, GSOname{}); e != nil ||
+		!sess.IsConfigSession() {
+		t.Errorf("GetSession(cs2) after conflict e: %v", e)
+	}
+	if e = db.ConfigDBTryLock("test"); e != nil {
+		t.Fatalf("ConfigDBTryLock() after conflict fails e: %v", e)
+	}
+	db.ConfigDBUnlock("test")
+}
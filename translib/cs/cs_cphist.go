@@ -24,8 +24,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
-	"github.com/Azure/sonic-mgmt-common/translib/transformer"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
 	"github.com/golang/glog"
 )
 
@@ -43,6 +46,12 @@ type CpHistEntry struct {
 	User   string `json:"user"`
 	Origin string `json:"origin"`
 	Time   int64  `json:"time"`
+
+	Comment string `json:"comment,omitempty"`
+	Pinned  bool   `json:"pinned,omitempty"`
+	Size    int64  `json:"size,omitempty"`   // Checkpoint file size
+	Sha256  string `json:"sha256,omitempty"` // Checkpoint file SHA-256 (hex)
+	File    string `json:"file,omitempty"`   // Checkpoint file name, if not Label or Id
 }
 
 type CpHistEntries struct {
@@ -54,6 +63,10 @@ const MAX_HIST = 10
 var cpHistEnts CpHistEntries
 var is_hist_loaded bool
 
+// cpHistMutex guards cpHistEnts, is_hist_loaded, and the checkpoint files.
+// When both are needed, csMutex is to be locked first.
+var cpHistMutex sync.Mutex
+
 func init() {
 	LoadCpHistEntries(checkPointHistPath)
 	if len(cpHistEnts.CpHistEntries) > 0 {
@@ -61,38 +74,91 @@ func init() {
 	}
 }
 
+// loadCpHist loads the checkpoint history, if not loaded yet.
+// cpHistMutex must be held by caller.
+func loadCpHist() {
+	if !is_hist_loaded {
+		loadCpHistFile(checkPointHistPath)
+		is_hist_loaded = true
+	}
+}
+
+// validateCpLabel checks that the label can name a checkpoint file in the
+// checkpoint dir. An empty label is allowed; the commit ID is used instead.
+func validateCpLabel(label string) error {
+	if label == "." || strings.Contains(label, "..") ||
+		strings.ContainsAny(label, "/\\"+string(filepath.Separator)) {
+		return tlerr.InvalidArgs("label: %s is not valid. It must not contain '/' or '..'", label)
+	}
+	if label == CpRunning {
+		return tlerr.InvalidArgs("label: %s is reserved for the running config", label)
+	}
+	return nil
+}
+
 func isCpLabelExist(label string) bool {
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	return cpHistEnts.hasLabel(label)
+}
+
+func (c *CpHistEntries) hasLabel(label string) bool {
 	if len(label) == 0 {
 		return false
 	}
-	for i := range cpHistEnts.CpHistEntries {
-		if label == cpHistEnts.CpHistEntries[i].Label || label == cpHistEnts.CpHistEntries[i].Id {
+	for i := range c.CpHistEntries {
+		if label == c.CpHistEntries[i].Label || label == c.CpHistEntries[i].Id ||
+			label == c.CpHistEntries[i].File {
 			return true
 		}
 	}
 	return false
 }
 
+// findCpHistEntry looks up the checkpoint history by label or commit ID.
+func findCpHistEntry(labelOrId string) (CpHistEntry, bool) {
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	loadCpHist()
+	if i := cpHistEnts.find(labelOrId); i >= 0 {
+		return cpHistEnts.CpHistEntries[i], true
+	}
+	return CpHistEntry{}, false
+}
+
+// find returns the index of the entry by label or commit ID, or -1.
+func (c *CpHistEntries) find(labelOrId string) int {
+	for i, ent := range c.CpHistEntries {
+		if len(labelOrId) != 0 &&
+			(labelOrId == ent.Label || labelOrId == ent.Id) {
+			return i
+		}
+	}
+	return -1
+}
+
+// cpFileName is the name of the checkpoint file of a history entry
+func (ent CpHistEntry) cpFileName() string {
+	if len(ent.File) > 0 {
+		return ent.File
+	}
+	if len(ent.Label) > 0 {
+		return ent.Label
+	}
+	return ent.Id
+}
+
 func (c *CpHistEntries) AddCpHistEntry(entry CpHistEntry) {
 	c.CpHistEntries = append(c.CpHistEntries, entry)
 }
 
 func SaveCpConfig(cpName string, user string) error {
-	q_result := transformer.HostQuery("cphist_mgmt.cp_cfg_save", cpName, user)
-	if q_result.Err != nil {
-		glog.Errorf("check point config save Query failed: err=%+v", q_result.Err)
-		return q_result.Err
-	}
-	return nil
+	_, err := cpStore.SaveConfig(cpName, user)
+	return err
 }
 
 func DeleteCpConfig(cpName string, user string) error {
-	q_result := transformer.HostQuery("cphist_mgmt.cp_cfg_remove", cpName, user)
-	if q_result.Err != nil {
-		glog.Errorf("check point config delete Query failed: err=%+v", q_result.Err)
-		return q_result.Err
-	}
-	return nil
+	return cpStore.DeleteConfig(cpName, user)
 }
 
 func CreateCpHistEntry(cpName string, id string, user string, origin string, time int64) error {
@@ -105,23 +171,11 @@ func CreateCpHistEntry(cpName string, id string, user string, origin string, tim
 		fileName = id
 	}
 
-	if !is_hist_loaded {
-		LoadCpHistEntries(checkPointHistPath)
-		is_hist_loaded = true
-	}
-
-	if len(cpHistEnts.CpHistEntries) >= MAX_HIST {
-		var delFileName string
-		if len(cpHistEnts.CpHistEntries[0].Label) > 0 {
-			delFileName = cpHistEnts.CpHistEntries[0].Label
-		} else {
-			delFileName = cpHistEnts.CpHistEntries[0].Id
-		}
-		DeleteCpConfig(delFileName, cpHistEnts.CpHistEntries[0].User)
-		cpHistEnts.DeleteFirstCpHistEntry()
-	}
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	loadCpHist()
 
-	err := SaveCpConfig(fileName, user)
+	info, err := cpStore.SaveConfig(fileName, user)
 	if err != nil {
 		return err
 	}
@@ -132,8 +186,12 @@ func CreateCpHistEntry(cpName string, id string, user string, origin string, tim
 	entry.User = user
 	entry.Origin = origin
 	entry.Time = time
+	entry.Size = info.Size
+	entry.Sha256 = info.Sha256
 	cpHistEnts.AddCpHistEntry(entry)
-	err = UpdateCpHistFile(user)
+	// The new checkpoint is kept, even if it alone exceeds the policy
+	evictCpHistEntries(user, id)
+	err = saveCpHistFile(user)
 	if err != nil {
 		return err
 	}
@@ -150,7 +208,7 @@ func (c *CpHistEntries) DeleteCpHistEntry(entry CpHistEntry) {
 		if v == entry {
 			//c.CpHistEntries = append(c.CpHistEntries[0:idx], c.CpHistEntries[idx+1:]...)
 			c.CpHistEntries[idx] = c.CpHistEntries[len(c.CpHistEntries)-1]
-			c.CpHistEntries[len(c.CpHistEntries)-1] = CpHistEntry{"", "", "", "", 0}
+			c.CpHistEntries[len(c.CpHistEntries)-1] = CpHistEntry{}
 			c.CpHistEntries = c.CpHistEntries[:len(c.CpHistEntries)-1]
 		}
 
@@ -158,6 +216,14 @@ func (c *CpHistEntries) DeleteCpHistEntry(entry CpHistEntry) {
 }
 
 func LoadCpHistEntries(fileName string) {
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	loadCpHistFile(fileName)
+}
+
+// loadCpHistFile reads the checkpoint history file.
+// cpHistMutex must be held by caller.
+func loadCpHistFile(fileName string) {
 	file, err := os.Open(fileName)
 
 	if err != nil {
@@ -179,16 +245,18 @@ func LoadCpHistEntries(fileName string) {
 }
 
 func UpdateCpHistFile(user string) error {
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	return saveCpHistFile(user)
+}
+
+// saveCpHistFile writes the checkpoint history file.
+// cpHistMutex must be held by caller.
+func saveCpHistFile(user string) error {
 	jData, err := json.MarshalIndent(cpHistEnts, "", "  ")
 	if err != nil {
 		glog.Errorf("converting to json data failed: err=%+v", err)
 		return err
 	}
-	js := string(jData)
-	q_result := transformer.HostQuery("cphist_mgmt.cp_hist_save", user, js)
-	if q_result.Err != nil {
-		glog.Errorf("check point config history save Query failed: err=%+v", q_result.Err)
-		return q_result.Err
-	}
-	return nil
+	return cpStore.SaveHistory(jData, user)
 }
@@ -0,0 +1,264 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Checkpoint Retention
+
+package cs
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/golang/glog"
+)
+
+// The retention policy is read from CONFIG_DB CHECKPOINT_RETENTION|global,
+// else from the retention file in the checkpoint dir, else the defaults.
+//
+//	max_count: Max number of checkpoints (default MAX_HIST)
+//	max_age:   Max age of a checkpoint in seconds (0: no limit)
+//	max_size:  Max total size of the checkpoint files in bytes (0: no limit)
+//
+// Pinned checkpoints are never evicted, nor counted against the limits.
+var cpRetentionTs = db.TableSpec{Name: "CHECKPOINT_RETENTION"}
+var cpRetentionKey = db.Key{Comp: []string{"global"}}
+var cpRetentionPath = checkPointPath + "retention.json"
+
+// CpRetention is the checkpoint retention policy
+type CpRetention struct {
+	MaxCount int           // Max number of unpinned checkpoints
+	MaxAge   time.Duration // Max age of unpinned checkpoints (0: no limit)
+	MaxSize  int64         // Max size of unpinned checkpoints (0: no limit)
+}
+
+type cpRetentionFile struct {
+	MaxCount int   `json:"max_count"`
+	MaxAge   int64 `json:"max_age"`
+	MaxSize  int64 `json:"max_size"`
+}
+
+// CpEviction is a checkpoint evicted by the retention policy
+type CpEviction struct {
+	Entry  CpHistEntry
+	Reason string
+}
+
+// CpHistUpdate holds the editable attributes of a checkpoint. nil
+// attributes are not changed.
+type CpHistUpdate struct {
+	Label   *string
+	Comment *string
+	Pinned  *bool
+}
+
+func defaultCpRetention() CpRetention {
+	return CpRetention{MaxCount: MAX_HIST}
+}
+
+// GetCpRetention returns the checkpoint retention policy in effect.
+func GetCpRetention() CpRetention {
+	if p, ok := readCpRetentionDB(); ok {
+		return p
+	}
+	if p, ok := readCpRetentionFile(cpRetentionPath); ok {
+		return p
+	}
+	return defaultCpRetention()
+}
+
+func readCpRetentionDB() (CpRetention, bool) {
+	p := defaultCpRetention()
+
+	d, err := db.NewDB(db.Options{DBNo: db.ConfigDB, IsWriteDisabled: true})
+	if err != nil {
+		glog.Warningf("readCpRetentionDB: NewDB err %v", err)
+		return p, false
+	}
+	defer d.DeleteDB()
+
+	value, err := d.GetEntry(&cpRetentionTs, cpRetentionKey)
+	if err != nil {
+		return p, false
+	}
+
+	if v, err := strconv.Atoi(value.Get("max_count")); err == nil {
+		p.MaxCount = v
+	}
+	if v, err := strconv.ParseInt(value.Get("max_age"), 10, 64); err == nil {
+		p.MaxAge = time.Duration(v) * time.Second
+	}
+	if v, err := strconv.ParseInt(value.Get("max_size"), 10, 64); err == nil {
+		p.MaxSize = v
+	}
+	return p, true
+}
+
+func readCpRetentionFile(fileName string) (CpRetention, bool) {
+	p := defaultCpRetention()
+
+	bData, err := os.ReadFile(fileName)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			glog.Errorf("readCpRetentionFile: err %v", err)
+		}
+		return p, false
+	}
+
+	f := cpRetentionFile{MaxCount: p.MaxCount}
+	if err = json.Unmarshal(bData, &f); err != nil {
+		glog.Errorf("readCpRetentionFile: unmarshal err %v", err)
+		return p, false
+	}
+
+	p.MaxCount = f.MaxCount
+	p.MaxAge = time.Duration(f.MaxAge) * time.Second
+	p.MaxSize = f.MaxSize
+	return p, true
+}
+
+// selectEvictions returns the unpinned checkpoints, oldest first, which
+// are to be evicted for the history to comply with the policy. The
+// checkpoint with the commit ID keep, if any, is kept as if pinned.
+func (c *CpHistEntries) selectEvictions(p CpRetention,
+	now time.Time, keep string) []CpEviction {
+
+	var unpinned []CpHistEntry
+	for _, ent := range c.CpHistEntries {
+		if !ent.Pinned && (len(keep) == 0 || ent.Id != keep) {
+			unpinned = append(unpinned, ent)
+		}
+	}
+	sort.SliceStable(unpinned, func(i, j int) bool {
+		return unpinned[i].Time < unpinned[j].Time
+	})
+
+	var totalSize int64
+	for _, ent := range unpinned {
+		totalSize += ent.Size
+	}
+
+	var evictions []CpEviction
+	for i, ent := range unpinned {
+		var reason string
+		switch {
+		case p.MaxCount > 0 && len(unpinned)-i > p.MaxCount:
+			reason = "max count " + strconv.Itoa(p.MaxCount)
+		case p.MaxAge > 0 && now.Sub(time.Unix(0, ent.Time)) > p.MaxAge:
+			reason = "max age " + p.MaxAge.String()
+		case p.MaxSize > 0 && totalSize > p.MaxSize:
+			reason = "max size " + strconv.FormatInt(p.MaxSize, 10)
+		default:
+			continue
+		}
+		totalSize -= ent.Size
+		evictions = append(evictions, CpEviction{Entry: ent, Reason: reason})
+	}
+
+	return evictions
+}
+
+// evictCpHistEntries removes the checkpoints which exceed the retention
+// policy, except the one with the commit ID keep. Each eviction is logged
+// with its reason. cpHistMutex must be held by caller.
+func evictCpHistEntries(user, keep string) []CpEviction {
+	evictions := cpHistEnts.selectEvictions(GetCpRetention(), time.Now(), keep)
+	for _, ev := range evictions {
+		glog.Infof("Evicting checkpoint %s (id %s, user %s, time %v): %s",
+			ev.Entry.Label, ev.Entry.Id, ev.Entry.User,
+			time.Unix(0, ev.Entry.Time), ev.Reason)
+		if err := DeleteCpConfig(ev.Entry.cpFileName(), ev.Entry.User); err != nil {
+			glog.Warningf("evictCpHistEntries: %s: err %v", ev.Entry.Id, err)
+		}
+		cpHistEnts.DeleteCpHistEntry(ev.Entry)
+	}
+	return evictions
+}
+
+// PruneCheckpoints applies the retention policy to the checkpoint history,
+// and returns the evicted checkpoints.
+func PruneCheckpoints(user string) ([]CpEviction, error) {
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	loadCpHist()
+	return pruneCpHist(user)
+}
+
+// pruneCpHist evicts the checkpoints, and saves the history if changed.
+// cpHistMutex must be held by caller.
+func pruneCpHist(user string) ([]CpEviction, error) {
+	evictions := evictCpHistEntries(user, "")
+	if len(evictions) == 0 {
+		return nil, nil
+	}
+	return evictions, saveCpHistFile(user)
+}
+
+// UpdateCheckpoint edits the label, comment or pin of the checkpoint with
+// the label or commit ID.
+func UpdateCheckpoint(labelOrId, user string, upd CpHistUpdate) error {
+	glog.Infof("UpdateCheckpoint: %s: user %s", labelOrId, user)
+
+	cpHistMutex.Lock()
+	defer cpHistMutex.Unlock()
+	loadCpHist()
+
+	idx := cpHistEnts.find(labelOrId)
+	if idx < 0 {
+		return tlerr.NotFound("checkpoint %s not found", labelOrId)
+	}
+
+	ent := cpHistEnts.CpHistEntries[idx]
+	newEnt := ent
+	if upd.Label != nil && *upd.Label != ent.Label {
+		if err := validateCpLabel(*upd.Label); err != nil {
+			return err
+		}
+		if cpHistEnts.hasLabel(*upd.Label) {
+			return tlerr.InvalidArgs("label: %s already exist. Choose another label",
+				*upd.Label)
+		}
+		// The checkpoint file keeps its name
+		newEnt.File = ent.cpFileName()
+		newEnt.Label = *upd.Label
+	}
+	if upd.Comment != nil {
+		newEnt.Comment = *upd.Comment
+	}
+	if upd.Pinned != nil {
+		newEnt.Pinned = *upd.Pinned
+	}
+
+	cpHistEnts.CpHistEntries[idx] = newEnt
+
+	if err := saveCpHistFile(user); err != nil {
+		return err
+	}
+
+	// Unpinning may exceed the limits
+	if upd.Pinned != nil && !*upd.Pinned {
+		_, err := pruneCpHist(user)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Checkpoint Retention Test
+
+package cs
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSelectEvictions(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) int64 { return now.Add(-d).UnixNano() }
+	hist := CpHistEntries{CpHistEntries: []CpHistEntry{
+		{Id: "1", Time: ago(5 * time.Hour), Size: 100, Pinned: true},
+		{Id: "2", Time: ago(4 * time.Hour), Size: 100},
+		{Id: "3", Time: ago(3 * time.Hour), Size: 100},
+		{Id: "4", Time: ago(2 * time.Hour), Size: 100},
+		{Id: "5", Time: ago(1 * time.Hour), Size: 100},
+	}}
+
+	tests := []struct {
+		name   string
+		policy CpRetention
+		keep   string
+		exp    []string
+	}{
+		{"none", CpRetention{MaxCount: 10}, "", nil},
+		{"count", CpRetention{MaxCount: 2}, "", []string{"2", "3"}},
+		{"age", CpRetention{MaxAge: 150 * time.Minute}, "", []string{"2", "3"}},
+		{"size", CpRetention{MaxSize: 250}, "", []string{"2", "3"}},
+		{"mixed", CpRetention{MaxCount: 3, MaxSize: 150}, "", []string{"2", "3", "4"}},
+		{"keep_newest", CpRetention{MaxSize: 50}, "5", []string{"2", "3", "4"}},
+		{"keep_oldest", CpRetention{MaxCount: 1}, "2", []string{"3", "4"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ids []string
+			for _, ev := range hist.selectEvictions(tc.policy, now, tc.keep) {
+				if ev.Entry.Pinned {
+					t.Errorf("pinned checkpoint %s evicted", ev.Entry.Id)
+				}
+				if len(ev.Reason) == 0 {
+					t.Errorf("checkpoint %s evicted without reason", ev.Entry.Id)
+				}
+				ids = append(ids, ev.Entry.Id)
+			}
+			if !reflect.DeepEqual(ids, tc.exp) {
+				t.Errorf("selectEvictions() = %v; expected %v", ids, tc.exp)
+			}
+		})
+	}
+}
+
+func TestReadCpRetentionFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "retention.json")
+	if _, ok := readCpRetentionFile(fileName); ok {
+		t.Errorf("readCpRetentionFile() on missing file succeeded")
+	}
+
+	data := `{"max_age": 86400, "max_size": 1048576}`
+	if err := os.WriteFile(fileName, []byte(data), 0644); err != nil {
+		t.Fatalf("WriteFile() fails e: %v", err)
+	}
+	exp := CpRetention{MaxCount: MAX_HIST, MaxAge: 24 * time.Hour,
+		MaxSize: 1048576}
+	if p, ok := readCpRetentionFile(fileName); !ok || p != exp {
+		t.Errorf("readCpRetentionFile() = %+v, %v; expected %+v", p, ok, exp)
+	}
+}
+
+func TestUpdateCheckpointBadLabel(t *testing.T) {
+	cpHistMutex.Lock()
+	oldEnts, oldLoaded := cpHistEnts, is_hist_loaded
+	cpHistEnts = CpHistEntries{CpHistEntries: []CpHistEntry{{Id: "1700000000-1", Label: "cp1"}}}
+	is_hist_loaded = true
+	cpHistMutex.Unlock()
+	t.Cleanup(func() {
+		cpHistMutex.Lock()
+		cpHistEnts, is_hist_loaded = oldEnts, oldLoaded
+		cpHistMutex.Unlock()
+	})
+
+	for _, label := range []string{"../cp2", "a/b", "..", CpRunning} {
+		label := label
+		if err := UpdateCheckpoint("cp1", user, CpHistUpdate{Label: &label}); err == nil {
+			t.Errorf("UpdateCheckpoint(%q) succeeded", label)
+		}
+	}
+	if cpHistEnts.CpHistEntries[0].Label != "cp1" {
+		t.Errorf("label updated to %q", cpHistEnts.CpHistEntries[0].Label)
+	}
+}
@@ -0,0 +1,230 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Checkpoint Store
+
+package cs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/Azure/sonic-mgmt-common/translib/transformer"
+	"github.com/golang/glog"
+)
+
+// CpStore saves and loads the checkpoint configs and the checkpoint history.
+type CpStore interface {
+	// SaveConfig saves the running config as the checkpoint name
+	SaveConfig(name, user string) (CpFileInfo, error)
+	// LoadConfig returns the config_db.json format checkpoint
+	LoadConfig(name string) ([]byte, error)
+	// DeleteConfig removes the checkpoint
+	DeleteConfig(name, user string) error
+	// SaveHistory saves the checkpoint history json
+	SaveHistory(data []byte, user string) error
+}
+
+// CpFileInfo describes a saved checkpoint
+type CpFileInfo struct {
+	Size   int64
+	Sha256 string // hex
+}
+
+var cpStore CpStore = &LocalCpStore{Dir: checkPointPath}
+
+// SetCpStore replaces the checkpoint store, and returns the previous one.
+func SetCpStore(s CpStore) CpStore {
+	old := cpStore
+	cpStore = s
+	return old
+}
+
+func newCpFileInfo(data []byte) CpFileInfo {
+	sum := sha256.Sum256(data)
+	return CpFileInfo{Size: int64(len(data)), Sha256: hex.EncodeToString(sum[:])}
+}
+
+// cpFileName is the checkpoint file of the name in dir. The name must not
+// lead out of the dir.
+func cpFileName(dir, name string) (string, error) {
+	if len(name) == 0 {
+		return "", tlerr.InvalidArgs("checkpoint name is empty")
+	}
+	if err := validateCpLabel(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".cp.json"), nil
+}
+
+// LocalCpStore keeps the checkpoints in the Dir of the local filesystem.
+// The files are written atomically (temp file and rename).
+type LocalCpStore struct {
+	Dir string
+}
+
+func (s *LocalCpStore) SaveConfig(name, user string) (CpFileInfo, error) {
+	fileName, err := cpFileName(s.Dir, name)
+	if err != nil {
+		return CpFileInfo{}, err
+	}
+
+	d, err := db.NewDB(db.Options{DBNo: db.ConfigDB, IsWriteDisabled: true})
+	if err != nil {
+		return CpFileInfo{}, err
+	}
+	defer d.DeleteDB()
+
+	cfg, err := readRunningCfg(d)
+	if err != nil {
+		return CpFileInfo{}, err
+	}
+	data, err := cfg.toJSON()
+	if err != nil {
+		return CpFileInfo{}, err
+	}
+
+	glog.Infof("LocalCpStore: save %s: user %s", name, user)
+	if err = writeFileAtomic(fileName, data); err != nil {
+		return CpFileInfo{}, err
+	}
+	return newCpFileInfo(data), nil
+}
+
+func (s *LocalCpStore) LoadConfig(name string) ([]byte, error) {
+	fileName, err := cpFileName(s.Dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(fileName)
+}
+
+func (s *LocalCpStore) DeleteConfig(name, user string) error {
+	fileName, err := cpFileName(s.Dir, name)
+	if err != nil {
+		return err
+	}
+	glog.Infof("LocalCpStore: delete %s: user %s", name, user)
+	err = os.Remove(fileName)
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return err
+}
+
+func (s *LocalCpStore) SaveHistory(data []byte, user string) error {
+	return writeFileAtomic(filepath.Join(s.Dir, "cp_hist.json"), data)
+}
+
+// writeFileAtomic writes the data to a temp file in the same dir, and
+// renames it to fileName; so the readers see either the old or the new file.
+func writeFileAtomic(fileName string, data []byte) error {
+	dir := filepath.Dir(fileName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		glog.Errorf("writeFileAtomic: %s: err %v", dir, err)
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, "."+filepath.Base(fileName)+".tmp*")
+	if err != nil {
+		glog.Errorf("writeFileAtomic: %s: err %v", fileName, err)
+		return err
+	}
+	tmpName := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmpName, 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmpName, fileName)
+	}
+	if err != nil {
+		glog.Errorf("writeFileAtomic: %s: err %v", fileName, err)
+		os.Remove(tmpName)
+	}
+	return err
+}
+
+// DBusCpStore saves the checkpoints through the host cphist_mgmt service.
+// The checkpoint dir is read locally.
+type DBusCpStore struct {
+	Dir string
+}
+
+func (s *DBusCpStore) SaveConfig(name, user string) (CpFileInfo, error) {
+	if err := validateCpLabel(name); err != nil {
+		return CpFileInfo{}, err
+	}
+	q_result := transformer.HostQuery("cphist_mgmt.cp_cfg_save", name, user)
+	if q_result.Err != nil {
+		glog.Errorf("check point config save Query failed: err=%+v", q_result.Err)
+		return CpFileInfo{}, q_result.Err
+	}
+
+	// The checkpoint is not recorded without the SHA-256 to verify it on load
+	data, err := s.LoadConfig(name)
+	if err != nil {
+		glog.Errorf("DBusCpStore: %s: saved checkpoint not readable: err %v", name, err)
+		if errD := s.DeleteConfig(name, user); errD != nil {
+			glog.Warningf("DBusCpStore: %s: errD %v", name, errD)
+		}
+		return CpFileInfo{}, err
+	}
+	return newCpFileInfo(data), nil
+}
+
+func (s *DBusCpStore) LoadConfig(name string) ([]byte, error) {
+	fileName, err := cpFileName(s.Dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(fileName)
+}
+
+func (s *DBusCpStore) DeleteConfig(name, user string) error {
+	if err := validateCpLabel(name); err != nil {
+		return err
+	}
+	q_result := transformer.HostQuery("cphist_mgmt.cp_cfg_remove", name, user)
+	if q_result.Err != nil {
+		glog.Errorf("check point config delete Query failed: err=%+v", q_result.Err)
+		return q_result.Err
+	}
+	return nil
+}
+
+func (s *DBusCpStore) SaveHistory(data []byte, user string) error {
+	q_result := transformer.HostQuery("cphist_mgmt.cp_hist_save", user, string(data))
+	if q_result.Err != nil {
+		glog.Errorf("check point config history save Query failed: err=%+v", q_result.Err)
+		return q_result.Err
+	}
+	return nil
+}
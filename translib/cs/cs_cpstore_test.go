@@ -0,0 +1,107 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Checkpoint Store Test
+
+package cs
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCfgDataToJSON(t *testing.T) {
+	cfg := cfgData{
+		"VLAN": {"Vlan10": {"vlanid": "10", "members@": "Ethernet0,Ethernet4"}},
+		"VRF":  {"Vrf1": {"NULL": "NULL"}},
+	}
+	data, err := cfg.toJSON()
+	if err != nil {
+		t.Fatalf("toJSON() fails e: %v", err)
+	}
+	if pcfg, err := parseCfgJSON(data); err != nil || !reflect.DeepEqual(pcfg, cfg) {
+		t.Errorf("parseCfgJSON(toJSON()) = %v, %v; expected %v", pcfg, err, cfg)
+	}
+}
+
+func TestLocalCpStoreBadName(t *testing.T) {
+	dir := t.TempDir()
+	s := &LocalCpStore{Dir: dir}
+	for _, name := range []string{"", "..", "../cp1", "a/b", "a\\b", "cp..1"} {
+		if _, err := s.SaveConfig(name, user); err == nil {
+			t.Errorf("SaveConfig(%q) succeeded", name)
+		}
+		if _, err := s.LoadConfig(name); err == nil {
+			t.Errorf("LoadConfig(%q) succeeded", name)
+		}
+		if err := s.DeleteConfig(name, user); err == nil {
+			t.Errorf("DeleteConfig(%q) succeeded", name)
+		}
+	}
+	if err := validateCpLabel("cp_1-a.b"); err != nil {
+		t.Errorf("validateCpLabel() fails e: %v", err)
+	}
+}
+
+func TestLocalCpStore(t *testing.T) {
+	dir := t.TempDir()
+	s := &LocalCpStore{Dir: dir}
+	old := SetCpStore(s)
+	t.Cleanup(func() { SetCpStore(old) })
+
+	data := []byte(`{"VRF": {"Vrf1": {}}}`)
+	if err := writeFileAtomic(filepath.Join(dir, "cp1.cp.json"), data); err != nil {
+		t.Fatalf("writeFileAtomic() fails e: %v", err)
+	}
+	if tmps, _ := filepath.Glob(filepath.Join(dir, ".*.tmp*")); len(tmps) != 0 {
+		t.Errorf("writeFileAtomic() left temp files %v", tmps)
+	}
+
+	ent := CpHistEntry{Label: "cp1", Id: "1700000000-1"}
+	ent.Sha256 = newCpFileInfo(data).Sha256
+	if _, err := loadCheckpointCfg(ent); err != nil {
+		t.Errorf("loadCheckpointCfg() fails e: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "cp1.cp.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatalf("WriteFile() fails e: %v", err)
+	}
+	if _, err := loadCheckpointCfg(ent); err == nil {
+		t.Errorf("loadCheckpointCfg() of modified checkpoint succeeded")
+	}
+
+	if err := s.DeleteConfig("cp1", user); err != nil {
+		t.Errorf("DeleteConfig() fails e: %v", err)
+	}
+	if _, err := s.LoadConfig("cp1"); !os.IsNotExist(err) {
+		t.Errorf("LoadConfig() after delete e: %v", err)
+	}
+	if err := s.DeleteConfig("cp1", user); err != nil {
+		t.Errorf("DeleteConfig() of missing checkpoint e: %v", err)
+	}
+
+	if err := s.SaveHistory([]byte(`{}`), user); err != nil {
+		t.Errorf("SaveHistory() fails e: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "cp_hist.json")); err != nil {
+		t.Errorf("SaveHistory() did not write the history e: %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Idle Timeout and Expiry
+
+package cs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// csReapInterval is how often the reaper looks for expired sessions
+	csReapInterval = 10 * time.Second
+
+	// csExpiredMax is the number of expired sessions remembered
+	csExpiredMax = 16
+)
+
+// Session expiry reasons
+const (
+	ExpiryIdleTimeout = "idle timeout"
+	ExpiryMaxLifetime = "max lifetime reached"
+)
+
+// csIdleTimeout and csMaxLifetime are the session expiry limits. Zero
+// value disables the limit. Protected by csMutex.
+var csIdleTimeout, csMaxLifetime time.Duration
+
+// csExpired maps the tokens of the recently expired sessions to the
+// reasons. Protected by csMutex.
+var csExpired = make(map[string]string)
+var csExpiredTokens []string
+
+var csReaperOnce sync.Once
+
+// SetSessionTimeouts configures the idle timeout and the maximum lifetime
+// of the config sessions; zero disables them. A session idle, i.e. without
+// requests, for longer than idleTimeout, or existing for longer than
+// maxLifetime, is aborted by a background reaper. Sessions waiting for the
+// commit confirmation are not aborted; the confirm timer governs them.
+func SetSessionTimeouts(idleTimeout, maxLifetime time.Duration) {
+	glog.Infof("SetSessionTimeouts: idle %v, max lifetime %v", idleTimeout, maxLifetime)
+
+	csMutex.Lock()
+	csIdleTimeout = idleTimeout
+	csMaxLifetime = maxLifetime
+	csMutex.Unlock()
+
+	if idleTimeout > 0 || maxLifetime > 0 {
+		csReaperOnce.Do(func() { go csReaper() })
+	}
+}
+
+// GetSessionTimeouts returns the idle timeout and the maximum lifetime
+// of the config sessions.
+func GetSessionTimeouts() (idleTimeout, maxLifetime time.Duration) {
+	csMutex.Lock()
+	defer csMutex.Unlock()
+	return csIdleTimeout, csMaxLifetime
+}
+
+func csReaper() {
+	glog.Infof("csReaper: started")
+	ticker := time.NewTicker(csReapInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		reapExpiredCS(now)
+	}
+}
+
+// reapExpiredCS aborts the sessions which have expired at time now. Owners
+// of the sessions are notified.
+func reapExpiredCS(now time.Time) {
+	csMutex.Lock()
+	var expired []Session
+	var reasons []string
+	for _, cs := range allCS() {
+		if reason := expiryReason(cs, now); len(reason) != 0 {
+			expired = append(expired, Session{configSession: *cs})
+			reasons = append(reasons, reason)
+		}
+	}
+	csMutex.Unlock()
+
+	for i, sess := range expired {
+		reapCS(sess, reasons[i], now)
+	}
+}
+
+func reapCS(sess Session, reason string, now time.Time) {
+	glog.Infof("reapExpiredCS:[%s]: Session expired: %s", sess.token, reason)
+	if reapCSLocked(sess, reason, now) {
+		sess.SendMesg("The configure session was aborted: "+reason, "system")
+	}
+}
+
+// reapCSLocked aborts the expired session, unless it has changed since.
+// Returns true if the session was aborted. Waits for the request using the
+// candidate config DB of the session, if any.
+func reapCSLocked(sess Session, reason string, now time.Time) bool {
+	csReqMutex.Lock()
+	defer csReqMutex.Unlock()
+	csMutex.Lock()
+	defer csMutex.Unlock()
+
+	// Session could have been committed or aborted meanwhile
+	cs := getCS(sess.name)
+	if cs == nil || cs.token != sess.token || expiryReason(cs, now) != reason {
+		glog.Infof("reapExpiredCS:[%s]: Session changed. Skip", sess.token)
+		return false
+	}
+
+	err, errU := deleteUCS(sess.name)
+	if err != nil || errU != nil {
+		glog.Warningf("reapExpiredCS:[%s]: err %v errU %v", sess.token, err, errU)
+	}
+	addExpiredCS(sess.token, reason)
+	return err == nil && errU == nil
+}
+
+// expiryReason returns why cs has expired at time now; or "" if it has
+// not. csMutex must be held by caller.
+func expiryReason(cs *configSession, now time.Time) string {
+	switch {
+	case cs.state != cs_STATE_ACTIVE && cs.state != cs_STATE_SUSPENDED:
+		return ""
+	case cs.commitState == cs_STATE_CONFIRM_TIMER ||
+		cs.commitState == cs_STATE_ROLLBACK_REPLACE:
+		return ""
+	case csMaxLifetime > 0 && now.Sub(cs.startTime) >= csMaxLifetime:
+		return ExpiryMaxLifetime
+	case csIdleTimeout > 0 && now.Sub(cs.lastActiveTime) >= csIdleTimeout:
+		return ExpiryIdleTimeout
+	}
+	return ""
+}
+
+// addExpiredCS records the expiry reason of a session. Only the last
+// csExpiredMax sessions are remembered. csMutex must be held by caller.
+func addExpiredCS(token, reason string) {
+	if len(csExpiredTokens) >= csExpiredMax {
+		delete(csExpired, csExpiredTokens[0])
+		csExpiredTokens = csExpiredTokens[1:]
+	}
+	csExpired[token] = reason
+	csExpiredTokens = append(csExpiredTokens, token)
+}
+
+// ExpiryReason returns why the session was aborted by the reaper;
+// or "" if it was not.
+func (sess *Session) ExpiryReason() string {
+	csMutex.Lock()
+	defer csMutex.Unlock()
+	return csExpired[sess.token]
+}
@@ -0,0 +1,136 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Expiry Test
+
+package cs
+
+import (
+	"testing"
+	"time"
+)
+
+func setTestTimeouts(t *testing.T, idleTimeout, maxLifetime time.Duration) {
+	csMutex.Lock()
+	csIdleTimeout, csMaxLifetime = idleTimeout, maxLifetime
+	csMutex.Unlock()
+	t.Cleanup(func() {
+		csMutex.Lock()
+		csIdleTimeout, csMaxLifetime = 0, 0
+		csMutex.Unlock()
+	})
+}
+
+func TestCSExpiry(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		idle        time.Duration
+		maxLifetime time.Duration
+		after       time.Duration
+		reason      string
+	}{
+		{"not_expired", time.Hour, 2 * time.Hour, time.Minute, ""},
+		{"disabled", 0, 0, 24 * time.Hour, ""},
+		{"idle", time.Minute, 0, 2 * time.Minute, ExpiryIdleTimeout},
+		{"max_lifetime", time.Hour, time.Minute, 2 * time.Minute, ExpiryMaxLifetime},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			setTestTimeouts(t, tc.idle, tc.maxLifetime)
+
+			u, e := newCS(sName, user, uR, pid)
+			if (u == nil) || (e != nil) {
+				t.Fatalf("newCS() fails e: %v", e)
+			}
+			t.Cleanup(func() { deleteCS(sName) })
+			token := u.token
+
+			reapExpiredCS(time.Now().Add(tc.after))
+
+			sess, e := GetSession(sName, token, user, uR, pid)
+			if len(tc.reason) == 0 {
+				if e != nil || !sess.IsConfigSession() {
+					t.Fatalf("Session expired; GetSession() e: %v", e)
+				}
+				return
+			}
+
+			if s, ok := e.(CsStatusInvalidSession); !ok || s.Tag != ErrTagExpired {
+				t.Errorf("GetSession() e: %v; expected %v", e, ErrTagExpired)
+			}
+			sess = Session{configSession: configSession{token: token}}
+			if r := sess.ExpiryReason(); r != tc.reason {
+				t.Errorf("ExpiryReason() = %q; expected %q", r, tc.reason)
+			}
+			if s := sess.GetState(); s != "EXPIRED ("+tc.reason+")" {
+				t.Errorf("GetState() = %q", s)
+			}
+			// Lock must be released
+			if u, e = newCS(sName, user, uR, pid); e != nil {
+				t.Fatalf("newCS() after expiry fails e: %v", e)
+			}
+		})
+	}
+}
+
+func TestCSExpiryCommitTimer(t *testing.T) {
+	setTestTimeouts(t, time.Minute, 0)
+
+	u, e := newCS(sName, user, uR, pid)
+	if (u == nil) || (e != nil) {
+		t.Fatalf("newCS() fails e: %v", e)
+	}
+	t.Cleanup(func() { cleanCS(sName) })
+
+	u.SetCommitState(cs_STATE_CONFIRM_TIMER)
+	reapExpiredCS(time.Now().Add(time.Hour))
+
+	if _, e = GetSession(sName, u.token, user, uR, pid); e != nil {
+		t.Fatalf("Session pending commit confirmation expired: %v", e)
+	}
+}
+
+func TestCSExpiryBusySession(t *testing.T) {
+	setTestTimeouts(t, time.Minute, 0)
+
+	u, e := newCS(sName, user, uR, pid)
+	if (u == nil) || (e != nil) {
+		t.Fatalf("newCS() fails e: %v", e)
+	}
+	t.Cleanup(func() { deleteCS(sName) })
+
+	// A request is using the candidate config DB
+	csReqMutex.Lock()
+	reaped := make(chan struct{})
+	go func() {
+		reapExpiredCS(time.Now().Add(time.Hour))
+		close(reaped)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if _, e = GetSession(sName, u.token, user, uR, pid); e != nil {
+		csReqMutex.Unlock()
+		t.Fatalf("Session expired during a request: %v", e)
+	}
+
+	csReqMutex.Unlock()
+	<-reaped
+	if _, e = GetSession(sName, u.token, user, uR, pid); e == nil {
+		t.Fatalf("Session not expired after the request")
+	}
+}
@@ -0,0 +1,186 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Export/Import of the Pending Changes
+
+package cs
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/golang/glog"
+)
+
+// PendingDocVersion is the version of the PendingDoc format
+const PendingDocVersion = 1
+
+// PendingDoc is the portable (JSON) form of the pending transaction of a
+// session, as returned by ExportPending.
+type PendingDoc struct {
+	Version int                    `json:"version"`
+	Hints   map[string]interface{} `json:"hints,omitempty"` // CVL hints
+	Ops     []PendingOp            `json:"ops"`
+}
+
+// PendingOp is one transaction command of the PendingDoc
+type PendingOp struct {
+	Op     string            `json:"op"` // db.TxCmdHMSet|TxCmdHDel|TxCmdDel
+	Table  string            `json:"table"`
+	Key    []string          `json:"key"`
+	Fields map[string]string `json:"fields,omitempty"`
+	Exists bool              `json:"exists"` // Key existed prior to the op
+}
+
+func (op PendingOp) name() string {
+	return op.Table + cfgKeySeparator + strings.Join(op.Key, cfgKeySeparator)
+}
+
+// ExportPending serializes the pending transaction commands and CVL hints
+// of the session to a PendingDoc JSON document. The CVL hints need to be
+// JSON serializable.
+func (sess *Session) ExportPending() ([]byte, error) {
+	if sess == nil || sess.ccDB == nil {
+		return nil, CsStatusInvalidSession{Tag: ErrTagInvalidState}
+	}
+
+	glog.Infof("ExportPending:[%s]: Begin", sess.token)
+
+	unlock, err := sess.lockRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	cmds, err := sess.ccDB.GetTxCmds()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := PendingDoc{Version: PendingDocVersion,
+		Hints: sess.ccDB.GetTxHints(),
+		Ops:   make([]PendingOp, 0, len(cmds)),
+	}
+	for _, cmd := range cmds {
+		doc.Ops = append(doc.Ops, PendingOp{
+			Op:     cmd.Op,
+			Table:  cmd.Ts.Name,
+			Key:    cmd.Key.Comp,
+			Fields: cmd.Value.Field,
+			Exists: cmd.Exists,
+		})
+	}
+
+	return json.MarshalIndent(&doc, "", "  ")
+}
+
+// ImportPending replays a document from ExportPending into the candidate
+// config of the session. The ops are validated by CVL, in order. The import
+// stops at the first key which exists (or not) contrary to the exported
+// session, or whose op is refused, with a CsStatusImportConflict. The ops
+// replayed until then are rolled back.
+func (sess *Session) ImportPending(doc []byte) error {
+	if sess == nil || sess.ccDB == nil {
+		return CsStatusInvalidSession{Tag: ErrTagInvalidState}
+	} else if sess.state != cs_STATE_ACTIVE {
+		return CsStatusInvalidSession{Tag: ErrTagNotActive}
+	}
+
+	glog.Infof("ImportPending:[%s]: Begin", sess.token)
+
+	unlock, err := sess.lockRequest()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var pDoc PendingDoc
+	if err = json.Unmarshal(doc, &pDoc); err != nil {
+		return tlerr.InvalidArgs("invalid pending changes document: %v", err)
+	}
+	if pDoc.Version != PendingDocVersion {
+		return tlerr.InvalidArgs("unsupported pending changes version %d",
+			pDoc.Version)
+	}
+
+	d := sess.ccDB
+	if err = sess.StartTx(d, nil, nil); err != nil {
+		return err
+	}
+
+	err = importPendingOps(d, &pDoc)
+	if err != nil {
+		glog.Warningf("ImportPending:[%s]: %v", sess.token, err)
+		if e := sess.AbortTx(d); e != nil {
+			glog.Errorf("ImportPending:[%s]: AbortTx: %v", sess.token, e)
+		}
+		return err
+	}
+
+	return sess.CommitTx(d)
+}
+
+func importPendingOps(d *db.DB, pDoc *PendingDoc) error {
+	for k, v := range pDoc.Hints {
+		if err := d.StoreCVLHint(k, v); err != nil {
+			return err
+		}
+	}
+
+	for _, op := range pDoc.Ops {
+		ts := &db.TableSpec{Name: op.Table}
+		key := db.Key{Comp: op.Key}
+		value := db.Value{Field: op.Fields}
+
+		_, err := d.GetEntry(ts, key)
+		if _, notFound := err.(tlerr.TranslibRedisClientEntryNotExist); notFound {
+			err = nil
+			if op.Exists {
+				err = tlerr.NotFound("entry does not exist")
+			}
+		} else if err == nil && !op.Exists {
+			err = tlerr.AlreadyExists("entry already exists")
+		}
+
+		if err == nil {
+			switch op.Op {
+			case db.TxCmdHMSet:
+				if op.Exists {
+					err = d.ModEntry(ts, key, value)
+				} else {
+					err = d.CreateEntry(ts, key, value)
+				}
+			case db.TxCmdHDel:
+				err = d.DeleteEntryFields(ts, key, value)
+			case db.TxCmdDel:
+				err = d.DeleteEntry(ts, key)
+			default:
+				err = tlerr.InvalidArgs("unknown op %s", op.Op)
+			}
+		}
+
+		if err != nil {
+			return CsStatusImportConflict{Key: op.name(), Err: err}
+		}
+	}
+
+	return nil
+}
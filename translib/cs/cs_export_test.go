@@ -0,0 +1,136 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Export/Import Test
+
+package cs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func newExportTestSession(t *testing.T) (*Session, *db.DB, func()) {
+	sess, e := GetSession(sName, "", user, uR, pid, GSOstrict{}, GSOname{})
+	if e != nil {
+		t.Fatalf("GetSession() GSOstrict|name{} fails e: %v", e)
+	}
+	if token, success, _ := sess.StartOrResume(pid); token == "" || !success {
+		t.Fatalf("StartOrResume() fails token: %v success: %v", token, success)
+	}
+
+	sess, e = GetSession(sName, "", user, uR, pid, GSOstrict{}, GSOname{})
+	if e != nil {
+		t.Fatalf("GetSession() GSOstrict|name{} fails e: %v", e)
+	}
+	d, isCS, cleanup, err := sess.GetConfigDB(&db.Options{DBNo: db.ConfigDB})
+	if d == nil || !isCS || err != nil {
+		t.Fatalf("GetConfigDB() fails isCS: %v, err: %v", isCS, err)
+	}
+	return sess, d, cleanup
+}
+
+func TestCSExportImportPending(t *testing.T) {
+	ts := &db.TableSpec{Name: "ACL_TABLE"}
+	key := db.Key{Comp: []string{"CsExportACL"}}
+	value := db.Value{Field: map[string]string{"type": "L3", "stage": "INGRESS"}}
+
+	// Export from a session
+	sess, d, cleanup := newExportTestSession(t)
+	e := sess.StartTx(d, nil, nil)
+	if e == nil {
+		if e = d.CreateEntry(ts, key, value); e == nil {
+			e = sess.CommitTx(d)
+		}
+	}
+	if e != nil {
+		cleanup()
+		deleteCS(sName)
+		t.Fatalf("CreateEntry() in session fails e: %v", e)
+	}
+	doc, e := sess.ExportPending()
+	cleanup()
+	deleteCS(sName)
+	if e != nil {
+		t.Fatalf("ExportPending() fails e: %v", e)
+	}
+
+	// Import into a new session
+	sess, _, cleanup = newExportTestSession(t)
+	t.Cleanup(func() { deleteCS(sName) })
+	defer cleanup()
+	if e = sess.ImportPending(doc); e != nil {
+		t.Fatalf("ImportPending() fails e: %v", e)
+	}
+	changes, e := sess.PendingChanges(PendingFormatDB)
+	if e != nil {
+		t.Fatalf("PendingChanges() fails e: %v", e)
+	}
+	if len(changes) != 1 || changes[0].Op != CfgChangeCreate ||
+		changes[0].Key != "CsExportACL" {
+		t.Errorf("PendingChanges() after ImportPending() = %+v", changes)
+	}
+
+	// Import again: the key exists now
+	e = sess.ImportPending(doc)
+	if conflict, ok := e.(CsStatusImportConflict); !ok ||
+		conflict.Key != "ACL_TABLE|CsExportACL" {
+		t.Errorf("ImportPending() again = %v; expected conflict", e)
+	}
+	if changes, _ = sess.PendingChanges(PendingFormatDB); len(changes) != 1 {
+		t.Errorf("PendingChanges() after conflict = %+v", changes)
+	}
+
+	if e = sess.ImportPending([]byte(`{"version": 0}`)); e == nil {
+		t.Errorf("ImportPending() of version 0 succeeds")
+	}
+}
+
+func TestCSExportPendingLock(t *testing.T) {
+	sess, _, cleanup := newExportTestSession(t)
+
+	// Waits for the request in progress
+	csReqMutex.Lock()
+	done := make(chan error)
+	go func() {
+		_, e := sess.ExportPending()
+		done <- e
+	}()
+	select {
+	case <-done:
+		t.Errorf("ExportPending() did not wait for the request lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+	csReqMutex.Unlock()
+	if e := <-done; e != nil {
+		t.Errorf("ExportPending() fails e: %v", e)
+	}
+
+	// Fails for a closed session
+	cleanup()
+	deleteCS(sName)
+	if _, e := sess.ExportPending(); e == nil {
+		t.Errorf("ExportPending() of closed session succeeds")
+	}
+	if e := sess.ImportPending([]byte(`{"version": 1}`)); e == nil {
+		t.Errorf("ImportPending() into closed session succeeds")
+	}
+}
@@ -22,10 +22,43 @@
 package cs
 
 import (
+	"sync"
+
 	"github.com/Azure/sonic-mgmt-common/translib/db"
 	"github.com/golang/glog"
 )
 
+// csReqMutex serializes the uses of the candidate config DBs of the
+// sessions, which are not safe for concurrent use.
+var csReqMutex sync.Mutex
+
+// RequestMutex returns the lock to be held while using the DB returned by
+// GetConfigDB. translib holds it for all its write requests and for the
+// reads in a session. PendingChanges, ExportPending, ImportPending and the
+// expiry reaper take it too.
+func RequestMutex() *sync.Mutex {
+	return &csReqMutex
+}
+
+// lockRequest takes csReqMutex for a request on the candidate config DB of
+// the session, and checks that the session was not closed (e.g. by the
+// expiry reaper) while waiting for it. The returned func releases the lock.
+func (sess *Session) lockRequest() (func(), error) {
+	csReqMutex.Lock()
+
+	csMutex.Lock()
+	cs := getCS(sess.name)
+	valid := cs != nil && cs.token == sess.token && cs.ccDB == sess.ccDB
+	csMutex.Unlock()
+
+	if !valid {
+		csReqMutex.Unlock()
+		glog.Warningf("lockRequest:[%s]: Session closed", sess.token)
+		return nil, CsStatusInvalidSession{Tag: ErrTagInvalidState}
+	}
+	return csReqMutex.Unlock, nil
+}
+
 func (sess *Session) GetConfigDB(opts *db.Options) (*db.DB, bool, func(),
 	error) {
 
@@ -53,8 +86,15 @@ func (sess *Session) GetConfigDB(opts *db.Options) (*db.DB, bool, func(),
 		glog.Infof("GetConfigDB: Session DB")
 		d = sess.ccDB
 		isCS = true
+
+		// Validation options of the request apply till cleanup
+		maxErrors := d.Opts.CVLMaxErrors
+		if opts != nil {
+			maxErrors = d.SetCVLMaxErrors(opts.CVLMaxErrors)
+		}
 		cleanup = func() {
 			sess.configSession.UpdateLastActiveTime()
+			d.SetCVLMaxErrors(maxErrors)
 
 			// Rollback the stale savepoint if exists (happens when the app module panics)
 			if d.HasSP() {
@@ -0,0 +1,92 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Pending Changes
+
+package cs
+
+import (
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/golang/glog"
+)
+
+// PendingFormat selects the YANG paths of the PendingChanges
+type PendingFormat int
+
+const (
+	PendingFormatDB         PendingFormat = iota // Table and key only
+	PendingFormatOpenConfig                      // OpenConfig YANG paths
+	PendingFormatSonic                           // sonic YANG paths
+)
+
+// PendingChanges returns the candidate config changes of the session,
+// consolidated per table and key, with the running config (Old) and the
+// candidate config (New) values. The changes are in the order the keys
+// were first modified.
+func (sess *Session) PendingChanges(format PendingFormat) ([]CfgChange, error) {
+	if sess == nil || sess.ccDB == nil {
+		return nil, CsStatusInvalidSession{Tag: ErrTagInvalidState}
+	}
+
+	glog.Infof("PendingChanges:[%s]: format %v", sess.token, format)
+
+	unlock, err := sess.lockRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	txChanges, err := sess.ccDB.GetTxChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]CfgChange, 0, len(txChanges))
+	for _, tc := range txChanges {
+		c := CfgChange{
+			Table: tc.Ts.Name,
+			Key:   strings.Join(tc.Key.Comp, cfgKeySeparator),
+			Op:    CfgChangeUpdate,
+			Old:   tc.Old.Field,
+			New:   tc.New.Field,
+		}
+		if len(c.Old) == 0 {
+			c.Op, c.Old = CfgChangeCreate, nil
+		} else if len(c.New) == 0 {
+			c.Op, c.New = CfgChangeDelete, nil
+		}
+		changes = append(changes, c)
+	}
+
+	if format != PendingFormatDB && len(changes) != 0 {
+		// The transformer resolves the keys from the candidate config
+		dbs := openReadOnlyDbs()
+		closeDbs(dbs[db.ConfigDB : db.ConfigDB+1])
+		dbs[db.ConfigDB] = sess.ccDB
+		defer func() {
+			dbs[db.ConfigDB] = nil
+			closeDbs(dbs[:])
+		}()
+		xlateCfgChanges(changes, format, dbs)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,105 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Pending Changes Test
+
+package cs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func TestCSPendingChanges(t *testing.T) {
+	sess, e := GetSession(sName, "", user, uR, pid, GSOstrict{}, GSOname{})
+	if e != nil {
+		t.Fatalf("GetSession() GSOstrict|name{} fails e: %v", e)
+	}
+	if token, success, _ := sess.StartOrResume(pid); token == "" || !success {
+		t.Fatalf("StartOrResume() fails token: %v success: %v", token, success)
+	}
+	t.Cleanup(func() { deleteCS(sName) })
+
+	sess, e = GetSession(sName, "", user, uR, pid, GSOstrict{}, GSOname{})
+	if e != nil {
+		t.Fatalf("GetSession() GSOstrict|name{} fails e: %v", e)
+	}
+
+	d, isCS, cleanup, err := sess.GetConfigDB(&db.Options{DBNo: db.ConfigDB})
+	if d == nil || !isCS || err != nil {
+		t.Fatalf("GetConfigDB() fails isCS: %v, err: %v", isCS, err)
+	}
+	defer cleanup()
+
+	ts := &db.TableSpec{Name: "ACL_TABLE"}
+	key := db.Key{Comp: []string{"CsPendingACL"}}
+	value := db.Value{Field: map[string]string{"type": "L3", "stage": "INGRESS"}}
+	if e = sess.StartTx(d, nil, nil); e == nil {
+		if e = d.CreateEntry(ts, key, value); e == nil {
+			e = sess.CommitTx(d)
+		}
+	}
+	if e != nil {
+		t.Fatalf("CreateEntry() in session fails e: %v", e)
+	}
+
+	changes, e := sess.PendingChanges(PendingFormatSonic)
+	if e != nil {
+		t.Fatalf("PendingChanges() fails e: %v", e)
+	}
+	exp := []CfgChange{{Table: "ACL_TABLE", Key: "CsPendingACL",
+		Op: CfgChangeCreate, New: value.Field,
+		YangPaths: []string{
+			"/sonic-acl:sonic-acl/ACL_TABLE/ACL_TABLE_LIST[aclname=CsPendingACL]"},
+	}}
+	if !reflect.DeepEqual(changes, exp) {
+		t.Errorf("PendingChanges() = %+v; expected %+v", changes, exp)
+	}
+}
+
+func TestCSPendingChangesLock(t *testing.T) {
+	sess, _, cleanup := newExportTestSession(t)
+
+	// Waits for the request in progress
+	csReqMutex.Lock()
+	done := make(chan error)
+	go func() {
+		_, e := sess.PendingChanges(PendingFormatDB)
+		done <- e
+	}()
+	select {
+	case <-done:
+		t.Errorf("PendingChanges() did not wait for the request lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+	csReqMutex.Unlock()
+	if e := <-done; e != nil {
+		t.Errorf("PendingChanges() fails e: %v", e)
+	}
+
+	// Fails for a closed session
+	cleanup()
+	deleteCS(sName)
+	if _, e := sess.PendingChanges(PendingFormatDB); e == nil {
+		t.Errorf("PendingChanges() of closed session succeeds")
+	}
+}
@@ -0,0 +1,222 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Pending Commit Confirmation Persistence
+
+package cs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/golang/glog"
+)
+
+// The commit waiting for confirmation is saved in STATE_DB, so that the
+// rollback still happens, or the commit can still be confirmed, after a
+// restart of the management process.
+var pendingCommitTs = db.TableSpec{Name: "CONFIG_SESSION_PENDING_COMMIT"}
+var pendingCommitKey = db.Key{Comp: []string{"confirm"}}
+
+// restoreMutex guards restoreDone, which is set after the first successful
+// restore; a failed restore is retried on the next call.
+var restoreMutex sync.Mutex
+var restoreDone bool
+
+// pendingCommit is the state of a commit waiting for confirmation
+type pendingCommit struct {
+	name        string
+	token       string
+	username    string
+	roles       []string
+	rollbackCfg string
+	commitTime  time.Time
+	deadline    time.Time
+}
+
+func newStateDB() (*db.DB, error) {
+	return db.NewDB(db.Options{DBNo: db.StateDB})
+}
+
+func savePendingCommit(pc pendingCommit) error {
+	glog.Infof("savePendingCommit:[%s]: deadline %v", pc.token, pc.deadline)
+
+	d, err := newStateDB()
+	if err != nil {
+		return err
+	}
+	defer d.DeleteDB()
+
+	v := db.Value{Field: map[string]string{}}
+	v.Set("name", pc.name)
+	v.Set("token", pc.token)
+	v.Set("username", pc.username)
+	v.SetList("roles", pc.roles)
+	v.Set("rollback_cfg", pc.rollbackCfg)
+	v.Set("commit_time", pc.commitTime.Format(time.RFC3339Nano))
+	v.Set("deadline", pc.deadline.Format(time.RFC3339Nano))
+
+	return d.SetEntry(&pendingCommitTs, pendingCommitKey, v)
+}
+
+// loadPendingCommit returns the saved commit waiting for confirmation;
+// or nil if there is none.
+func loadPendingCommit() (*pendingCommit, error) {
+	d, err := newStateDB()
+	if err != nil {
+		return nil, err
+	}
+	defer d.DeleteDB()
+
+	v, err := d.GetEntry(&pendingCommitTs, pendingCommitKey)
+	if _, notFound := err.(tlerr.TranslibRedisClientEntryNotExist); notFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	pc := pendingCommit{
+		name:        v.Get("name"),
+		token:       v.Get("token"),
+		username:    v.Get("username"),
+		roles:       v.GetList("roles"),
+		rollbackCfg: v.Get("rollback_cfg"),
+	}
+	if pc.commitTime, err = time.Parse(time.RFC3339Nano, v.Get("commit_time")); err == nil {
+		pc.deadline, err = time.Parse(time.RFC3339Nano, v.Get("deadline"))
+	}
+	if err != nil || len(pc.token) == 0 || len(pc.rollbackCfg) == 0 {
+		glog.Errorf("loadPendingCommit: Invalid entry %v: err %v", v, err)
+		return nil, tlerr.New("Invalid pending commit state")
+	}
+
+	return &pc, nil
+}
+
+func deletePendingCommit() error {
+	glog.Infof("deletePendingCommit:")
+
+	d, err := newStateDB()
+	if err != nil {
+		return err
+	}
+	defer d.DeleteDB()
+
+	return d.DeleteEntry(&pendingCommitTs, pendingCommitKey)
+}
+
+// RestorePendingCommit rehydrates the commit waiting for confirmation
+// saved by an earlier instance of the process. The session is restored in
+// "PENDING CONFIRM" state and the confirm timer is restarted for the
+// remaining time; the rollback happens right away if the deadline has
+// passed. It runs till it succeeds once; management servers should call it
+// on startup. Otherwise it runs on GetSession().
+func RestorePendingCommit() error {
+	restoreMutex.Lock()
+	defer restoreMutex.Unlock()
+
+	if restoreDone {
+		return nil
+	}
+	if err := restorePendingCommit(); err != nil {
+		glog.Errorf("RestorePendingCommit: err %v", err)
+		return err
+	}
+	restoreDone = true
+	return nil
+}
+
+func restorePendingCommit() error {
+	pc, err := loadPendingCommit()
+	if err != nil || pc == nil {
+		return err
+	}
+
+	glog.Infof("restorePendingCommit:[%s]: deadline %v", pc.token, pc.deadline)
+
+	csMutex.Lock()
+
+	if cs := getCS(pc.name); cs != nil {
+		csMutex.Unlock()
+		if cs.token == pc.token && cs.commitState == cs_STATE_CONFIRM_TIMER {
+			return nil
+		}
+		glog.Warningf("restorePendingCommit: Session %s exists", cs.token)
+		return tlerr.TranslibBusy{}
+	}
+
+	// Other writers stay blocked till the commit is confirmed or rolled back;
+	// without the lock, the rollback could undo their changes.
+	if err = db.ConfigDBTryLock(pc.token); err != nil {
+		csMutex.Unlock()
+		glog.Errorf("restorePendingCommit: db.ConfigDBTryLock err %s", err)
+		return err
+	}
+
+	ccDB, err := db.NewDB(db.Options{DBNo: db.ConfigDB,
+		IsSession: true,
+		TxCmdsLim: ccDbTxCmdsLim})
+	if err == nil {
+		if err = csStartTx(ccDB); err != nil {
+			ccDB.DeleteDB()
+		}
+	}
+	if err != nil {
+		db.ConfigDBUnlock(pc.token)
+		csMutex.Unlock()
+		glog.Errorf("restorePendingCommit: ccDB err %s", err)
+		return err
+	}
+	ccDB.Opts.IsCommitted = true
+
+	cs := &configSession{
+		name:        pc.name,
+		token:       pc.token,
+		state:       cs_STATE_ACTIVE,
+		username:    pc.username,
+		roles:       pc.roles,
+		ccDB:        ccDB,
+		startTime:   pc.commitTime,
+		commitTime:  pc.commitTime,
+		commitState: cs_STATE_CONFIRM_TIMER,
+		rollbackCfg: pc.rollbackCfg,
+		concurrent:  len(pc.name) != 0,
+	}
+	cs.lastActiveTime = time.Now()
+	setCS(pc.name, cs)
+	sess := Session{configSession: *cs}
+
+	csMutex.Unlock()
+
+	timeout := time.Until(pc.deadline)
+	if timeout < 0 {
+		timeout = 0
+	}
+	if ok, status := processCommitTimer(&sess, timeout, pc.rollbackCfg); !ok {
+		// Retried by the next RestorePendingCommit()
+		cleanCS(pc.name)
+		if err, isErr := status.(error); isErr {
+			return err
+		}
+		return tlerr.New(status.Status())
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Pending Commit Persistence Test
+
+package cs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func newTestPendingCommit(deadline time.Time) pendingCommit {
+	return pendingCommit{
+		name:        sName,
+		token:       "1700000000-1",
+		username:    user,
+		roles:       uR,
+		rollbackCfg: "temp://rollback.cfg.1.json",
+		commitTime:  time.Now().Round(0),
+		deadline:    deadline.Round(0),
+	}
+}
+
+func TestPendingCommitSaveLoad(t *testing.T) {
+	pc := newTestPendingCommit(time.Now().Add(time.Minute))
+	if err := savePendingCommit(pc); err != nil {
+		t.Fatalf("savePendingCommit() fails e: %v", err)
+	}
+	t.Cleanup(func() { deletePendingCommit() })
+
+	lpc, err := loadPendingCommit()
+	if err != nil || lpc == nil {
+		t.Fatalf("loadPendingCommit() = %v, %v", lpc, err)
+	}
+	if !lpc.commitTime.Equal(pc.commitTime) || !lpc.deadline.Equal(pc.deadline) {
+		t.Errorf("loadPendingCommit() times %v %v; expected %v %v",
+			lpc.commitTime, lpc.deadline, pc.commitTime, pc.deadline)
+	}
+	lpc.commitTime, lpc.deadline = pc.commitTime, pc.deadline
+	if !reflect.DeepEqual(*lpc, pc) {
+		t.Errorf("loadPendingCommit() = %+v; expected %+v", *lpc, pc)
+	}
+
+	if err = deletePendingCommit(); err != nil {
+		t.Fatalf("deletePendingCommit() fails e: %v", err)
+	}
+	if lpc, err = loadPendingCommit(); err != nil || lpc != nil {
+		t.Errorf("loadPendingCommit() after delete = %v, %v", lpc, err)
+	}
+}
+
+func TestRestorePendingCommit(t *testing.T) {
+	pc := newTestPendingCommit(time.Now().Add(time.Hour))
+	if err := savePendingCommit(pc); err != nil {
+		t.Fatalf("savePendingCommit() fails e: %v", err)
+	}
+	t.Cleanup(func() { deletePendingCommit() })
+
+	if err := restorePendingCommit(); err != nil {
+		t.Fatalf("restorePendingCommit() fails e: %v", err)
+	}
+	t.Cleanup(func() {
+		uCS.commitTimer.Stop()
+		uCS.commitCh <- true
+		cleanCS(sName)
+	})
+
+	sess, err := GetSession(sName, pc.token, user, uR, pid)
+	if err != nil {
+		t.Fatalf("GetSession() fails e: %v", err)
+	}
+	if s := sess.GetState(); s != "PENDING CONFIRM" {
+		t.Errorf("GetState() = %q; expected \"PENDING CONFIRM\"", s)
+	}
+	if sess.rollbackCfg != pc.rollbackCfg || sess.username != pc.username {
+		t.Errorf("Restored session %v; expected %+v", sess.String(), pc)
+	}
+}
+
+func TestRestorePendingCommitRetry(t *testing.T) {
+	pc := newTestPendingCommit(time.Now().Add(time.Hour))
+	if err := savePendingCommit(pc); err != nil {
+		t.Fatalf("savePendingCommit() fails e: %v", err)
+	}
+	t.Cleanup(func() { deletePendingCommit() })
+
+	restoreMutex.Lock()
+	restoreDone = false
+	restoreMutex.Unlock()
+
+	// The ConfigDB lock held by another writer fails the restore
+	const other = "1700000000-2"
+	if err := db.ConfigDBTryLock(other); err != nil {
+		t.Fatalf("ConfigDBTryLock() fails e: %v", err)
+	}
+	err := RestorePendingCommit()
+	db.ConfigDBUnlock(other)
+	if err == nil {
+		t.Fatalf("RestorePendingCommit() with ConfigDB locked succeeded")
+	}
+	if getCS(sName) != nil {
+		t.Fatalf("Session restored with ConfigDB locked")
+	}
+
+	if err = RestorePendingCommit(); err != nil {
+		t.Fatalf("RestorePendingCommit() retry fails e: %v", err)
+	}
+	t.Cleanup(func() {
+		uCS.commitTimer.Stop()
+		uCS.commitCh <- true
+		cleanCS(sName)
+	})
+	if cs := getCS(sName); cs == nil || cs.commitState != cs_STATE_CONFIRM_TIMER {
+		t.Errorf("Session not restored: %v", cs)
+	}
+}
@@ -0,0 +1,215 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// Config Session Rollback
+
+package cs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/Azure/sonic-mgmt-common/translib/utils"
+	"github.com/golang/glog"
+)
+
+// CsStatusRollbackFailure indicates that the running config was not changed
+type CsStatusRollbackFailure struct {
+	Err error
+}
+
+func (s CsStatusRollbackFailure) Status() string {
+	return fmt.Sprintf("Rollback Failure: %s", s.Err)
+}
+
+func (s CsStatusRollbackFailure) Error() string {
+	return s.Status()
+}
+
+// RollbackTo restores the running config to the checkpoint with the
+// label or commit ID. Only the difference between the running config and
+// the checkpoint is written, in one CVL validated ConfigDB transaction.
+// The resulting config is recorded in the checkpoint history with origin
+// "rollback".
+func RollbackTo(labelOrId, user string) (bool, CsStatus) {
+	glog.Infof("RollbackTo: %s: user %s", labelOrId, user)
+
+	// The running config must not change between the diff and the write.
+	// Serialize with the translib writes of this process; the ConfigDB
+	// lock below keeps out the session commits and other processes.
+	csReqMutex.Lock()
+	defer csReqMutex.Unlock()
+	csMutex.Lock()
+	defer csMutex.Unlock()
+
+	// The unnamed session holds the ConfigDB lock for its lifetime, and
+	// any session holds it until the Commit is confirmed. The concurrent
+	// sessions see the rollback as conflicts at commit.
+	for _, cs := range allCS() {
+		if !cs.concurrent || cs.commitState == cs_STATE_CONFIRM_TIMER {
+			glog.Warningf("RollbackTo: session %s exists", cs.token)
+			return false, CsStatusRollbackFailure{Err: tlerr.TranslibBusy{}}
+		}
+	}
+
+	ent, ok := findCpHistEntry(labelOrId)
+	if !ok {
+		return false, CsStatusRollbackFailure{
+			Err: tlerr.NotFound("checkpoint %s not found", labelOrId)}
+	}
+
+	cpCfg, err := loadCheckpointCfg(ent)
+	if err != nil {
+		return false, CsStatusRollbackFailure{Err: err}
+	}
+
+	// NewDB takes the ConfigDB lock, and holds it till DeleteDB; i.e.
+	// across readRunningCfg and applyCfgChanges.
+	d, err := db.NewDB(db.Options{DBNo: db.ConfigDB})
+	if err != nil {
+		return false, CsStatusRollbackFailure{Err: err}
+	}
+	defer d.DeleteDB()
+
+	runCfg, err := readRunningCfg(d)
+	if err != nil {
+		return false, CsStatusRollbackFailure{Err: err}
+	}
+
+	changes := diffCfg(runCfg, cpCfg)
+	if err = applyCfgChanges(d, changes); err != nil {
+		return false, CsStatusRollbackFailure{Err: err}
+	}
+
+	id := fmt.Sprintf("%d-%d", time.Now().Unix(),
+		atomic.AddUint32(&csTokenCtr, 1))
+	if errSh := CreateCpHistEntry("", id, user, "rollback",
+		time.Now().UnixNano()); errSh != nil {
+		glog.Warningf("RollbackTo: CreateCpHistEntry errSh %+v", errSh)
+		return true, CsStatusCommitWarning{CheckpointFailure: errSh}
+	}
+
+	return true, CsStatusCommitSuccess{}
+}
+
+// applyCfgChanges writes the changes in one transaction. Deletes are done
+// child tables first, and creates and updates parent tables first, so that
+// the leafrefs are valid at every step.
+func applyCfgChanges(d *db.DB, changes []CfgChange) error {
+	if len(changes) == 0 {
+		glog.Info("applyCfgChanges: no changes")
+		return nil
+	}
+
+	order, err := sortCfgTables(changes)
+	if err != nil {
+		return err
+	}
+
+	byTable := make(map[string][]CfgChange, len(order))
+	for _, c := range changes {
+		byTable[c.Table] = append(byTable[c.Table], c)
+	}
+
+	if err = d.StartTx(nil, nil); err != nil {
+		return err
+	}
+
+	for _, table := range order {
+		for _, c := range byTable[table] {
+			if c.Op == CfgChangeDelete {
+				if err = writeCfgChange(d, c); err != nil {
+					goto applyCfgChangesAbort
+				}
+			}
+		}
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		for _, c := range byTable[order[i]] {
+			if c.Op != CfgChangeDelete {
+				if err = writeCfgChange(d, c); err != nil {
+					goto applyCfgChangesAbort
+				}
+			}
+		}
+	}
+
+	if err = d.CommitTx(); err != nil {
+		glog.Errorf("applyCfgChanges: CommitTx err %v", err)
+	}
+	return err
+
+applyCfgChangesAbort:
+	glog.Errorf("applyCfgChanges: err %v", err)
+	d.AbortTx()
+	return err
+}
+
+func writeCfgChange(d *db.DB, c CfgChange) error {
+	ts := &db.TableSpec{Name: c.Table}
+	key := db.Key{Comp: strings.Split(c.Key, cfgKeySeparator)}
+
+	switch c.Op {
+	case CfgChangeCreate:
+		return d.CreateEntry(ts, key, db.Value{Field: c.New})
+	case CfgChangeUpdate:
+		return d.SetEntry(ts, key, db.Value{Field: c.New})
+	case CfgChangeDelete:
+		return d.DeleteEntry(ts, key)
+	}
+	return tlerr.InvalidArgs("unknown change op %v", c.Op)
+}
+
+// sortCfgTables returns the tables of the changes, child tables first.
+// Tables without dependency info (no schema) are placed at the end.
+func sortCfgTables(changes []CfgChange) ([]string, error) {
+	var tables []string
+	seen := make(map[string]bool)
+	for _, c := range changes {
+		if !seen[c.Table] {
+			seen[c.Table] = true
+			tables = append(tables, c.Table)
+		}
+	}
+
+	sorted, err := utils.SortAsPerTblDeps(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	inSorted := make(map[string]bool, len(sorted))
+	for _, table := range sorted {
+		inSorted[table] = true
+	}
+	var rest []string
+	for _, table := range tables {
+		if !inSorted[table] {
+			rest = append(rest, table)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(sorted, rest...), nil
+}
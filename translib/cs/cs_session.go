@@ -49,6 +49,9 @@ func GetSession(name string, token string, username string, roles []string,
 	glog.Infof("GetSession:[%s]:[%s]: %s %v %d %#v", name, token,
 		username, roles, pid, opts)
 
+	// Commit waiting for confirmation before a process restart
+	RestorePendingCommit()
+
 	var err error
 	var session Session
 	var gsoStrict bool
@@ -67,7 +70,13 @@ func GetSession(name string, token string, username string, roles []string,
 
 	csMutex.Lock()
 	defer csMutex.Unlock()
-	ucs := uCS
+
+	var ucs *configSession
+	if gsoName {
+		ucs = getCS(name)
+	} else if len(token) != 0 {
+		ucs = findCSByToken(token)
+	}
 
 	session = Session{configSession: configSession{name: name, token: token,
 		username: username, roles: roles, pid: pid}}
@@ -80,15 +89,7 @@ func GetSession(name string, token string, username string, roles []string,
 
 	} else if ucs != nil {
 
-		if gsoName && ucs.name != name {
-			glog.Warningf("GetSession: name mismatch %s != %s", name, ucs.name)
-			err = CsStatusInvalidSession{Tag: ErrTagNameNotFound}
-
-		} else if (!gsoName) && (ucs.token != token) {
-			glog.Warningf("GetSession: token mismatch %s != %s", token, ucs.token)
-			err = CsStatusInvalidSession{Tag: ErrTagTokenNotFound}
-
-		} else if gsoStrict && (ucs.username != username) {
+		if gsoStrict && (ucs.username != username) {
 			glog.Warningf("GetSession: user mismatch %s != %s", username,
 				ucs.username)
 			err = CsStatusInvalidSession{Tag: ErrTagInvalidUser}
@@ -111,21 +112,42 @@ func GetSession(name string, token string, username string, roles []string,
 
 	} else if len(token) != 0 {
 		glog.Warningf("GetSession[%s][%s]: Token. No Session", name, token)
-		err = CsStatusInvalidSession{Tag: ErrTagTokenNotFound}
+		err = CsStatusInvalidSession{Tag: tokenNotFoundTag(token)}
 	}
 
 	return session, err
 }
 
+// findCSByToken returns the session with the token. csMutex must be held
+// by caller.
+func findCSByToken(token string) *configSession {
+	for _, cs := range allCS() {
+		if cs.token == token {
+			return cs
+		}
+	}
+	return nil
+}
+
+// tokenNotFoundTag returns the ErrTag for a failed lookup by token.
+// csMutex must be held by caller.
+func tokenNotFoundTag(token string) ErrTag {
+	if _, expired := csExpired[token]; expired {
+		return ErrTagExpired
+	}
+	return ErrTagTokenNotFound
+}
+
 // GetAllSessions returns all session info from cache
 func GetAllSessions() ([]Session, error) {
 	csMutex.Lock()
 	defer csMutex.Unlock()
 
 	var allSess []Session
-	if uCS != nil && uCS.state != cs_STATE_None {
-		sess := Session{configSession: *uCS}
-		allSess = append(allSess, sess)
+	for _, cs := range allCS() {
+		if cs.state != cs_STATE_None {
+			allSess = append(allSess, Session{configSession: *cs})
+		}
 	}
 
 	return allSess, nil
@@ -168,7 +190,9 @@ func (sess *Session) IsActive() bool {
 
 func (sess *Session) GetState() string {
 	var state string
-	if sess.commitState == cs_STATE_CONFIRM_TIMER {
+	if reason := sess.ExpiryReason(); len(reason) != 0 {
+		state = "EXPIRED (" + reason + ")"
+	} else if sess.commitState == cs_STATE_CONFIRM_TIMER {
 		state = "PENDING CONFIRM"
 	} else if sess.commitState == cs_STATE_ROLLBACK_REPLACE {
 		state = "ROLLBACK IN PROGRESS"
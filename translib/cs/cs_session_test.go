@@ -411,3 +411,22 @@ func TestCSTxNoSession(t *testing.T) {
 		t.Errorf("CommitTx() fails e: %v", e)
 	}
 }
+
+func TestCSGetConfigDBOptions(t *testing.T) {
+	sess, _, cleanup := newExportTestSession(t)
+	cleanup()
+	t.Cleanup(func() { deleteCS(sName) })
+
+	d, isCS, cleanup, err := sess.GetConfigDB(
+		&db.Options{DBNo: db.ConfigDB, CVLMaxErrors: 5})
+	if d == nil || !isCS || err != nil {
+		t.Fatalf("GetConfigDB() fails isCS: %v, err: %v", isCS, err)
+	}
+	if d.Opts.CVLMaxErrors != 5 {
+		t.Errorf("GetConfigDB() CVLMaxErrors = %d; expected 5", d.Opts.CVLMaxErrors)
+	}
+	cleanup()
+	if d.Opts.CVLMaxErrors != 0 {
+		t.Errorf("CVLMaxErrors after cleanup = %d; expected 0", d.Opts.CVLMaxErrors)
+	}
+}
@@ -23,7 +23,9 @@ package cs
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/Azure/sonic-mgmt-common/translib/db"
 	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
 )
 
@@ -59,6 +61,7 @@ const (
 	ErrTagActive          ErrTag = "cs-active"          // Op not allowed on active CS
 	ErrTagNotActive       ErrTag = "cs-inactive"        // Op not allowed on suspended CS
 	ErrTagInvalidState    ErrTag = "cs-invalid-state"   // Op not allowed in current CS state
+	ErrTagExpired         ErrTag = "cs-expired"         // CS aborted on idle timeout or max lifetime
 	ErrTagUnknown         ErrTag = "cs-unknown"         // Any unexpected CS context (mostly code error)
 )
 
@@ -147,6 +150,46 @@ func (s CsStatusAbortWarning) Status() string {
 	return "Aborted with warning"
 }
 
+// CsStatusConflict indicates that the commit of a concurrent session was
+// refused, because the running config of the Keys ("TABLE|key") was
+// modified after the session read or wrote them.
+type CsStatusConflict struct {
+	Keys []string
+}
+
+func newCsStatusConflict(conflicts []db.TxConflict) CsStatusConflict {
+	keys := make([]string, 0, len(conflicts))
+	for _, c := range conflicts {
+		keys = append(keys, c.Ts.Name+cfgKeySeparator+
+			strings.Join(c.Key.Comp, cfgKeySeparator))
+	}
+	return CsStatusConflict{Keys: keys}
+}
+
+func (s CsStatusConflict) Status() string {
+	return fmt.Sprintf("Commit Conflict: %s", strings.Join(s.Keys, ", "))
+}
+
+func (s CsStatusConflict) Error() string {
+	return s.Status()
+}
+
+// CsStatusImportConflict indicates that ImportPending stopped at the Key
+// ("TABLE|key"), because its state differs from the exported session, or
+// its write was refused (Err).
+type CsStatusImportConflict struct {
+	Key string
+	Err error
+}
+
+func (s CsStatusImportConflict) Status() string {
+	return fmt.Sprintf("Import Conflict: %s: %v", s.Key, s.Err)
+}
+
+func (s CsStatusImportConflict) Error() string {
+	return s.Status()
+}
+
 type CsStatusInternalError struct {
 	Err error
 }
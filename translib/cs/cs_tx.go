@@ -43,7 +43,7 @@ func (sess *Session) StartTx(d *db.DB, w []db.WatchKeys,
 func (sess *Session) CommitTx(d *db.DB) error {
 	glog.Infof("cs.CommitTx:[%s]: Begin", sess.token)
 	var e error
-	if (uCS != nil) && (d == uCS.ccDB) {
+	if isCSDB(d) {
 		e = d.ReleaseSP()
 	} else {
 		e = d.CommitTx()
@@ -54,7 +54,7 @@ func (sess *Session) CommitTx(d *db.DB) error {
 func (sess *Session) AbortTx(d *db.DB) error {
 	glog.Infof("cs.AbortTx:[%s]: Begin", sess.token)
 	var e error
-	if (uCS != nil) && (d == uCS.ccDB) {
+	if isCSDB(d) {
 		e = d.Rollback2SP()
 	} else {
 		e = d.AbortTx()
@@ -62,8 +62,24 @@ func (sess *Session) AbortTx(d *db.DB) error {
 	return e
 }
 
+// isCSDB returns true if d is the Candidate Config DB of a session
+func isCSDB(d *db.DB) bool {
+	csMutex.Lock()
+	defer csMutex.Unlock()
+	for _, cs := range allCS() {
+		if d == cs.ccDB {
+			return true
+		}
+	}
+	return false
+}
+
 func csStartTx(d *db.DB) error {
 	glog.Infof("csStartTx: Begin")
+	if d.Opts.TrackBaseVersion {
+		// Conflicts are checked per key at commit (CheckTxConflicts)
+		return d.StartSessTx(nil, nil)
+	}
 	return d.StartSessTx(nil, []*db.TableSpec{&(db.TableSpec{Name: "*"})})
 }
 
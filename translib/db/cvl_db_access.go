@@ -43,10 +43,42 @@ func (d *DB) NewValidationSession() (*cvl.CVL, error) {
 	if c, status := cvl.ValidationSessOpen(&cvlDBAccess{d}); status != cvl.CVL_SUCCESS {
 		return nil, tlerr.TranslibCVLFailure{Code: int(status)}
 	} else {
+		c.SetMaxErrors(d.Opts.CVLMaxErrors)
 		return c, nil
 	}
 }
 
+// SetCVLMaxErrors changes the Options.CVLMaxErrors of the DB, and of its
+// open CVL session. Returns the previous value.
+func (d *DB) SetCVLMaxErrors(maxErrors int) int {
+	prev := d.Opts.CVLMaxErrors
+	d.Opts.CVLMaxErrors = maxErrors
+	if d.cv != nil {
+		d.cv.SetMaxErrors(maxErrors)
+	}
+	return prev
+}
+
+// newNonConfigValidationSession opens a CVL session for validating the
+// writes to d, which is not ConfigDB. References to ConfigDB tables are
+// resolved through a read-only ConfigDB connection, returned as configDB.
+// Caller must close both; doNonConfigCVL reuses them till DeleteDB.
+func (d *DB) newNonConfigValidationSession() (c *cvl.CVL, configDB *DB, err error) {
+	c, status := cvl.ValidationSessOpenDB(d.Opts.DBNo.Name(), &cvlDBAccess{d})
+	if status != cvl.CVL_SUCCESS {
+		return nil, nil, tlerr.TranslibCVLFailure{Code: int(status)}
+	}
+
+	configDB, err = NewDB(Options{DBNo: ConfigDB, IsWriteDisabled: true})
+	if err != nil {
+		cvl.ValidationSessClose(c)
+		return nil, nil, err
+	}
+
+	c.SetDBAccess(ConfigDB.Name(), &cvlDBAccess{configDB})
+	return c, configDB, nil
+}
+
 func NewValidationSession() (*cvl.CVL, error) {
 	if c, status := cvl.ValidationSessOpen(nil); status != cvl.CVL_SUCCESS {
 		return nil, tlerr.TranslibCVLFailure{Code: int(status)}
@@ -153,6 +185,11 @@ func (c *cvlDBAccess) getTxData(pattern string, incRow bool) ([]byte, error) {
 }
 
 func (c *cvlDBAccess) Lookup(s ctypes.Search) ctypes.JsonResult {
+	if c.Db.Opts.DBNo != ConfigDB {
+		// Lua scripts work on ConfigDB only
+		return ctypes.LookupEntries(c, s, c.Db.Opts.TableNameSeparator)
+	}
+
 	var count string
 	if s.Limit > 0 {
 		count = strconv.Itoa(s.Limit)
@@ -180,6 +217,11 @@ func (c *cvlDBAccess) Lookup(s ctypes.Search) ctypes.JsonResult {
 }
 
 func (c *cvlDBAccess) Count(s ctypes.Search) ctypes.IntResult {
+	if c.Db.Opts.DBNo != ConfigDB {
+		// Lua scripts work on ConfigDB only
+		return ctypes.CountEntries(c, s, c.Db.Opts.TableNameSeparator)
+	}
+
 	incRow := len(s.Predicate) > 0 || len(s.WithField) > 0
 	txEntries, err := c.getTxData(s.Pattern, incRow)
 	if err != nil {
@@ -320,6 +362,7 @@ func (pr *pipeKeysResult) update(c *cvlDBAccess) {
 	if log.V(5) {
 		log.Infof("dbAccessPipe: TableSpec: %v, Key: %v", ts, dbKey)
 	}
+	c.Db.recordScan(&ts, dbKey, keys)
 
 	for k := range c.Db.txTsEntryMap[ts.Name] {
 		if patternMatch(k, 0, pr.pattern, 0) {
@@ -388,6 +431,7 @@ func (pr *pipeHMGetResult) update(c *cvlDBAccess) {
 	if pr.rsRes != nil {
 		pr.sRes.val = pr.rsRes.Val()
 		pr.sRes.err = pr.rsRes.Err()
+		recordPipeBase(c, pr.key)
 	} else {
 		pr.sRes.val = pr.vals
 		pr.sRes.err = nil
@@ -433,6 +477,7 @@ func (pr *pipeHGetResult) update(c *cvlDBAccess) {
 	if pr.rsRes != nil {
 		pr.sRes.val = pr.rsRes.Val()
 		pr.sRes.err = pr.rsRes.Err()
+		recordPipeBase(c, pr.key)
 	} else if !pr.fldExist {
 		pr.sRes.err = redis.Nil
 	} else {
@@ -479,12 +524,25 @@ func (pr *pipeHGetAllResult) update(c *cvlDBAccess) {
 	if pr.rsRes != nil {
 		pr.sRes.val = pr.rsRes.Val()
 		pr.sRes.err = pr.rsRes.Err()
+		if pr.sRes.err == nil {
+			ts, dbKey := c.Db.redis2ts_key(pr.key)
+			c.Db.recordBase(&ts, dbKey, Value{Field: pr.sRes.val})
+		}
 	} else {
 		pr.sRes.val = pr.fnvMap
 		pr.sRes.err = nil
 	}
 }
 
+// recordPipeBase records the base version of a key whose fields were read
+// by the pipeline. The whole entry is read, as the fields are not enough.
+func recordPipeBase(c *cvlDBAccess, key string) {
+	ts, dbKey := c.Db.redis2ts_key(key)
+	if err := c.Db.recordBaseFromDB(&ts, dbKey); err != nil {
+		log.Warningf("dbAccessPipe: recordBaseFromDB %v: %v", key, err)
+	}
+}
+
 func (p *dbAccessPipe) Exec() error {
 	if log.V(5) {
 		log.Infof("dbAccessPipe: Exec: query list: %v", p.qryResList)
@@ -185,20 +185,32 @@ type Options struct {
 	IsReplaced  bool // Is candidate Config DB updated by config-replace operation.
 	IsCommitted bool // Is candidate Config DB committed.
 
+	// TrackBaseVersion records the value of each key when it is first read
+	// or written in the transaction, for CheckTxConflicts at commit. For
+	// Candidate Config DBs which do not hold the ConfigDB lock.
+	TrackBaseVersion bool
+
 	// Alternate Datastore: By default, we query redis CONFIG_DB.
 	// Front-end an alternate source of data. (Eg: config_db.cp.json
 	// from a saved commit-id, or snapshot)
 	Datastore DBDatastore
 
 	DisableCVLCheck bool
+
+	// CVLMaxErrors is the maximum number of CVL failures collected in a
+	// transaction. By default, a write fails at the first CVL failure.
+	// When it is more than 1, failed writes are let through until the
+	// maximum is reached, and CommitTx fails reporting all of them.
+	CVLMaxErrors int
 }
 
 func (o Options) String() string {
 	return fmt.Sprintf(
-		"{ DBNo: %v, InitIndicator: %v, TableNameSeparator: %v, KeySeparator: %v, IsWriteDisabled: %v, IsCacheEnabled: %v, IsOnChangeEnabled: %v, SDB: %v, DisableCVLCheck: %v, IsSession: %v, ConfigDBLazyLock: %v, TxCmdsLim: %v }",
+		"{ DBNo: %v, InitIndicator: %v, TableNameSeparator: %v, KeySeparator: %v, IsWriteDisabled: %v, IsCacheEnabled: %v, IsOnChangeEnabled: %v, SDB: %v, DisableCVLCheck: %v, IsSession: %v, ConfigDBLazyLock: %v, TxCmdsLim: %v, CVLMaxErrors: %v, TrackBaseVersion: %v }",
 		o.DBNo, o.InitIndicator, o.TableNameSeparator, o.KeySeparator,
 		o.IsWriteDisabled, o.IsCacheEnabled, o.IsOnChangeEnabled, o.SDB,
-		o.DisableCVLCheck, o.IsSession, o.ConfigDBLazyLock, o.TxCmdsLim)
+		o.DisableCVLCheck, o.IsSession, o.ConfigDBLazyLock, o.TxCmdsLim,
+		o.CVLMaxErrors, o.TrackBaseVersion)
 }
 
 type _txState int
@@ -291,9 +303,20 @@ type DB struct {
 	// it need not be read again.
 	txTsEntryHGetAll map[string]map[string]Value //map[TableSpec.Name]map[Entry]Value
 
+	// Base version of the keys read or written (Opts.TrackBaseVersion)
+	txBase  map[string]*txBaseEntry // map[Entry]
+	txScans map[string]*txScanEntry // map[Pattern]
+
 	cv                *cvl.CVL
 	cvlHintsB4Open    map[string]interface{} // Hints set before CVLSess Opened
+	txHints           map[string]interface{} // Hints stored in the Tx
 	cvlEditConfigData []cmn.CVLEditConfigData
+	cvlErrors         []cvl.CVLErrorInfo // Deferred CVL failures (CVLMaxErrors)
+
+	// CVL session, and its ConfigDB, for the writes to non ConfigDB.
+	// Opened on the first write, and reused till DeleteDB.
+	ncCV       *cvl.CVL
+	ncConfigDB *DB
 
 	// If there is an error while Rollback (or similar), set this flag.
 	// In this state, all writes are disabled, and this error is returned.
@@ -566,6 +589,12 @@ func (d *DB) DeleteDB() error {
 		d.unRegisterSessionDB()
 	}
 
+	if d.ncCV != nil {
+		cvl.ValidationSessClose(d.ncCV)
+		d.ncConfigDB.DeleteDB()
+		d.ncCV, d.ncConfigDB = nil, nil
+	}
+
 	err := d.client.Close()
 	d.client = nil
 	return err
@@ -672,6 +701,7 @@ func (d *DB) getEntry(ts *TableSpec, key Key, forceReadDB bool) (Value, error) {
 				if value, ok = table.entry[entry]; ok {
 					value = value.Copy()
 					cacheHit = true
+					d.recordBase(ts, key, value)
 				}
 			}
 		}
@@ -687,6 +717,9 @@ func (d *DB) getEntry(ts *TableSpec, key Key, forceReadDB bool) (Value, error) {
 		}
 		v, e = d.client.HGetAll(entry).Result()
 		value = Value{Field: v}
+		if e == nil {
+			d.recordBase(ts, key, value)
+		}
 	}
 
 	if e != nil {
@@ -838,6 +871,7 @@ func (d *DB) GetKeysPattern(ts *TableSpec, pat Key) ([]Key, error) {
 		for i := 0; i < len(redisKeys); i++ {
 			keys = append(keys, d.redis2key(ts, redisKeys[i]))
 		}
+		d.recordScan(ts, pat, redisKeys)
 
 		// If cache SetCache (i.e. a cache miss)
 		if d.dbCacheConfig.PerConnection && d.dbCacheConfig.isCacheTable(ts.Name) {
@@ -978,7 +1012,7 @@ func (d *DB) doCVL(ts *TableSpec, cvlOps []cmn.CVLOperation, key Key, vals []Val
 	var e error = nil
 
 	var cvlRetCode cvl.CVLRetCode
-	var cei cvl.CVLErrorInfo
+	var cvlErrs []cvl.CVLErrorInfo
 
 	if d.err != nil {
 		e = d.err
@@ -991,6 +1025,13 @@ func (d *DB) doCVL(ts *TableSpec, cvlOps []cmn.CVLOperation, key Key, vals []Val
 		goto doCVLExit
 	}
 
+	// Other DBs do not have transactions. Validate each write, if the
+	// table has schema.
+	if d.Opts.DBNo != ConfigDB {
+		e = d.doNonConfigCVL(ts, cvlOps, key, vals)
+		goto doCVLExit
+	}
+
 	// No Transaction case. No CVL.
 	if d.txState == txStateNone {
 		glog.Info("doCVL: No Transactions. Skipping CVL")
@@ -1039,13 +1080,21 @@ func (d *DB) doCVL(ts *TableSpec, cvlOps []cmn.CVLOperation, key Key, vals []Val
 		glog.Info("doCVL: calling ValidateEditConfig: ", d.cvlEditConfigData)
 	}
 
-	cei, cvlRetCode = d.cv.ValidateEditConfig(d.cvlEditConfigData)
+	cvlErrs, cvlRetCode = d.cv.ValidateEditConfigAll(d.cvlEditConfigData)
 
 	if cvl.CVL_SUCCESS != cvlRetCode {
 		glog.Warning("doCVL: CVL Failure: ", cvlRetCode)
 		// e = errors.New("CVL Failure: " + string(cvlRetCode))
-		e = tlerr.TranslibCVLFailure{Code: int(cvlRetCode),
-			CVLErrorInfo: cei}
+		if d.Opts.CVLMaxErrors > 1 &&
+			len(d.cvlErrors)+len(cvlErrs) < d.Opts.CVLMaxErrors {
+			// Carry on to collect the errors of the remaining writes.
+			// CommitTx fails with all of them.
+			glog.Info("doCVL: Deferring CVL Failure: ", len(cvlErrs))
+			d.cvlErrors = append(d.cvlErrors, cvlErrs...)
+		} else {
+			e = newCVLFailure(append(d.cvlErrors, cvlErrs...))
+			d.cvlErrors = nil
+		}
 		glog.Info("doCVL: ", len(d.cvlEditConfigData), len(cvlOps))
 		d.cvlEditConfigData = d.cvlEditConfigData[:len(d.cvlEditConfigData)-len(cvlOps)]
 	} else {
@@ -1063,6 +1112,68 @@ doCVLExit:
 	return e
 }
 
+// doNonConfigCVL validates a write to a DB other than ConfigDB. Tables
+// without schema for the DB are not validated.
+func (d *DB) doNonConfigCVL(ts *TableSpec, cvlOps []cmn.CVLOperation, key Key, vals []Value) error {
+	dbName := d.Opts.DBNo.Name()
+	if !cvl.TableHasSchema(dbName, ts.Name) {
+		if glog.V(3) {
+			glog.Infof("doNonConfigCVL: No schema for %s table %s. Skipping CVL", dbName, ts.Name)
+		}
+		return nil
+	}
+
+	if len(cvlOps) != len(vals) {
+		glog.Error("doNonConfigCVL: Incorrect arguments len(cvlOps) != len(vals)")
+		return errors.New("CVL Incorrect args")
+	}
+
+	cfgData := make([]cmn.CVLEditConfigData, len(cvlOps))
+	for i := range cvlOps {
+		cfgData[i] = cmn.CVLEditConfigData{
+			VType: cmn.VALIDATE_ALL,
+			VOp:   cvlOps[i],
+			Key:   d.key2redis(ts, key),
+			Data:  map[string]string{},
+		}
+		if len(vals[i].Field) != 0 {
+			cfgData[i].Data = vals[i].Copy().Field
+		}
+	}
+
+	if d.ncCV == nil {
+		var err error
+		if d.ncCV, d.ncConfigDB, err = d.newNonConfigValidationSession(); err != nil {
+			glog.Error("doNonConfigCVL: Could not open CVL session: ", err)
+			return err
+		}
+	}
+	c := d.ncCV
+	defer c.ResetSession()
+
+	c.SetMaxErrors(d.Opts.CVLMaxErrors)
+	if cvlErrs, cvlRetCode := c.ValidateEditConfigAll(cfgData); cvlRetCode != cvl.CVL_SUCCESS {
+		glog.Warning("doNonConfigCVL: CVL Failure: ", cvlRetCode)
+		if len(cvlErrs) == 0 {
+			return tlerr.TranslibCVLFailure{Code: int(cvlRetCode)}
+		}
+		return newCVLFailure(cvlErrs)
+	}
+
+	return nil
+}
+
+// newCVLFailure returns the error for the CVL validation failures errs.
+// The first failure is reported as the CVLErrorInfo.
+func newCVLFailure(errs []cvl.CVLErrorInfo) error {
+	e := tlerr.TranslibCVLFailure{Code: int(errs[0].ErrCode),
+		CVLErrorInfo: errs[0]}
+	if len(errs) > 1 {
+		e.Errors = errs
+	}
+	return e
+}
+
 func (d *DB) doWrite(ts *TableSpec, op _txOp, k Key, val interface{}) error {
 	var e error = nil
 	var value Value
@@ -1193,6 +1304,9 @@ func (d *DB) doWrite(ts *TableSpec, op _txOp, k Key, val interface{}) error {
 			var v map[string]string
 			glog.Info("doWrite: RedisCmd: ", d.Name(), ": ", "HGETALL ", d.key2redis(ts, key))
 			v, e = d.client.HGetAll(d.key2redis(ts, key)).Result()
+			if e == nil {
+				d.recordBase(ts, key, Value{Field: v})
+			}
 			if len(v) != 0 {
 				d.txTsEntryMap[ts.Name][entry] = Value{Field: v}
 			} else {
@@ -1214,6 +1328,9 @@ func (d *DB) doWrite(ts *TableSpec, op _txOp, k Key, val interface{}) error {
 
 	case txOpDel:
 		entry := d.key2redis(ts, key)
+		if e = d.recordBaseFromDB(ts, key); e != nil {
+			goto doWriteExit
+		}
 		delete(d.txTsEntryMap[ts.Name], entry)
 		d.txTsEntryMap[ts.Name][entry] = Value{Field: make(map[string]string)}
 
@@ -1564,6 +1681,12 @@ func (d *DB) StartTx(w []WatchKeys, tss []*TableSpec) error {
 
 func (d *DB) CommitTx() error {
 	defer d.clearCVLHint("")
+	if len(d.cvlErrors) != 0 {
+		e := newCVLFailure(d.cvlErrors)
+		glog.Warning("CommitTx: Deferred CVL Failure: ", e)
+		d.AbortTx()
+		return e
+	}
 	if d.Opts.IsSession {
 		return d.ReleaseSP()
 	}
@@ -1571,6 +1694,7 @@ func (d *DB) CommitTx() error {
 }
 
 func (d *DB) AbortTx() error {
+	d.cvlErrors = nil
 	if d.Opts.IsSession {
 		// Rollback creates the CVL Session again -- with only the
 		// pre-DeclareSP() CVL Hints.
@@ -1596,6 +1720,10 @@ func (d *DB) startTx(w []WatchKeys, tss []*TableSpec) error {
 
 	d.txTsEntryMap = make(map[string]map[string]Value)
 	d.txTsEntryHGetAll = make(map[string]map[string]Value)
+	d.txBase = nil
+	d.txScans = nil
+	d.txHints = nil
+	d.cvlErrors = nil
 
 	var e error = nil
 
@@ -1852,7 +1980,7 @@ func (d *DB) commitTx() error {
 
 	if e != nil {
 		glog.Warning("CommitTx: Do: EXEC e: ", e.Error())
-		e = tlerr.TranslibTransactionFail{}
+		e = d.execFailure()
 	}
 
 CommitTxExit:
@@ -1862,6 +1990,9 @@ CommitTxExit:
 	d.cvlEditConfigData = d.cvlEditConfigData[:0]
 	d.txTsEntryMap = make(map[string]map[string]Value)
 	d.txTsEntryHGetAll = make(map[string]map[string]Value)
+	d.txBase = nil
+	d.txScans = nil
+	d.txHints = nil
 
 	//Close CVL session
 	if d.cv != nil {
@@ -1924,6 +2055,9 @@ AbortTxExit:
 	d.cvlEditConfigData = d.cvlEditConfigData[:0]
 	d.txTsEntryMap = make(map[string]map[string]Value)
 	d.txTsEntryHGetAll = make(map[string]map[string]Value)
+	d.txBase = nil
+	d.txScans = nil
+	d.txHints = nil
 
 	//Close CVL session
 	if d.cv != nil {
@@ -2058,6 +2192,7 @@ func (d *DB) getEntries(ts *TableSpec, keys []Key, forceReadDB bool) ([]Value, [
 				if value, ok := tbl.entry[entry]; ok {
 					values[idx] = value.Copy()
 					cacheHit = true
+					d.recordBase(ts, key, value)
 				}
 			}
 		} else {
@@ -2116,6 +2251,7 @@ func (d *DB) getEntries(ts *TableSpec, keys []Key, forceReadDB bool) ([]Value, [
 				} else {
 					dbValue.Field = res
 					values[keyIdx] = dbValue
+					d.recordBase(ts, keys[keyIdx], dbValue)
 				}
 
 				if len(dbValue.Field) != 0 {
@@ -121,6 +121,10 @@ func (d *DB) NewScanCursor(ts *TableSpec, pattern Key, scOpts *ScanCursorOpts) (
 		return nil, err
 	}
 
+	if err := d.recordScanFromDB(ts, pattern); err != nil {
+		return nil, err
+	}
+
 	var countHint int64 = 10
 	scnType := KeyScanType // default is key scanner
 
@@ -0,0 +1,118 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"testing"
+
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+)
+
+func newCVLMaxErrorsDB(t *testing.T, maxErrors int) *DB {
+	d, e := NewDB(Options{
+		DBNo:               ConfigDB,
+		InitIndicator:      "",
+		TableNameSeparator: "|",
+		KeySeparator:       "|",
+		CVLMaxErrors:       maxErrors,
+	})
+	if e != nil {
+		t.Fatalf("NewDB() fails e: %v", e)
+	}
+
+	t.Cleanup(func() { d.DeleteDB() })
+	return d
+}
+
+func createInvalidVlans(d *DB) (errs []error) {
+	ts := &TableSpec{Name: "VLAN"}
+	for _, vlan := range []struct{ name, id string }{
+		{"Vlan731", "7031"},
+		{"Vlan732", "732"},
+		{"Vlan733", "7033"},
+	} {
+		key := Key{Comp: []string{vlan.name}}
+		value := Value{Field: map[string]string{"vlanid": vlan.id}}
+		errs = append(errs, d.CreateEntry(ts, key, value))
+	}
+	return
+}
+
+func TestCVLMaxErrors_Default(t *testing.T) {
+	d := newCVLMaxErrorsDB(t, 0)
+	if e := d.StartTx(nil, nil); e != nil {
+		t.Fatalf("StartTx() fails e: %v", e)
+	}
+
+	errs := createInvalidVlans(d)
+	if _, ok := errs[0].(tlerr.TranslibCVLFailure); !ok {
+		t.Errorf("CreateEntry(Vlan731) expected CVL failure; got %v", errs[0])
+	}
+	if errs[1] != nil {
+		t.Errorf("CreateEntry(Vlan732) fails e: %v", errs[1])
+	}
+
+	d.AbortTx()
+}
+
+func TestCVLMaxErrors_Deferred(t *testing.T) {
+	d := newCVLMaxErrorsDB(t, 5)
+	if e := d.StartTx(nil, nil); e != nil {
+		t.Fatalf("StartTx() fails e: %v", e)
+	}
+
+	for i, e := range createInvalidVlans(d) {
+		if e != nil {
+			t.Errorf("CreateEntry #%d fails e: %v", i, e)
+		}
+	}
+
+	e := d.CommitTx()
+	cvlErr, ok := e.(tlerr.TranslibCVLFailure)
+	if !ok {
+		t.Fatalf("CommitTx() expected CVL failure; got %v", e)
+	}
+	if len(cvlErr.Errors) != 2 {
+		t.Fatalf("CommitTx() expected 2 errors; got %v", cvlErr.Errors)
+	}
+	for i, vlan := range []string{"Vlan731", "Vlan733"} {
+		if keys := cvlErr.Errors[i].Keys; len(keys) != 1 || keys[0] != vlan {
+			t.Errorf("Error #%d expected for %s; got %v", i, vlan, cvlErr.Errors[i])
+		}
+	}
+}
+
+func TestCVLMaxErrors_Limit(t *testing.T) {
+	d := newCVLMaxErrorsDB(t, 2)
+	if e := d.StartTx(nil, nil); e != nil {
+		t.Fatalf("StartTx() fails e: %v", e)
+	}
+
+	errs := createInvalidVlans(d)
+	if errs[0] != nil || errs[1] != nil {
+		t.Errorf("CreateEntry fails before the limit: %v", errs[:2])
+	}
+	cvlErr, ok := errs[2].(tlerr.TranslibCVLFailure)
+	if !ok || len(cvlErr.Errors) != 2 {
+		t.Errorf("CreateEntry(Vlan733) expected 2 CVL errors; got %v", errs[2])
+	}
+
+	d.AbortTx()
+}
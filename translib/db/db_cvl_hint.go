@@ -58,6 +58,7 @@ func (d *DB) StoreCVLHint(key string, value interface{}) error {
 	} else if d.Opts.DisableCVLCheck {
 		glog.Info("StoreCVLHint: CVL Disabled. Skipping CVL")
 	} else {
+		d.saveTxHint(key, value)
 		if d.cv != nil {
 			// TBD Wait for the CVL PR
 			// if crCode := d.cv.StoreHint(key, value); crCode != cvl.CVL_SUCCESS {
@@ -95,6 +96,7 @@ func (d *DB) clearCVLHint(key string) {
 		glog.Warningf("clearCVLHint: (d == nil): %t (d.client == nil): %t",
 			d == nil, d.client == nil)
 	} else if d.cv != nil {
+		delete(d.txHints, key)
 		// TBD Wait for the CVL PR
 		// if crCode := d.cv.StoreHint(key, nil); crCode != cvl.CVL_SUCCESS {
 		// 	glog.Errorf("clearCVLHint: crCode: %v", crCode)
@@ -102,3 +104,11 @@ func (d *DB) clearCVLHint(key string) {
 		d.doCHintSave(key, nil)
 	}
 }
+
+// saveTxHint records the hint for GetTxHints
+func (d *DB) saveTxHint(key string, value interface{}) {
+	if d.txHints == nil {
+		d.txHints = make(map[string]interface{})
+	}
+	d.txHints[key] = value
+}
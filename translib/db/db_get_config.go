@@ -218,6 +218,7 @@ func (d *DB) GetConfig(tables []*TableSpec, opt *GetConfigOptions) (map[TableSpe
 			}
 
 			tblM[ts].entry[redisKey] = dbValue
+			d.recordBase(tss[index], keys[index], dbValue)
 		}
 	}
 
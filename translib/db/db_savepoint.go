@@ -63,6 +63,9 @@ type _savePoint struct {
 	// Record the CVL Hints (with the length of cECDLen at the time they were
 	// invoked)
 	cHints map[int]map[string]interface{}
+
+	// Record the Tx Hints (GetTxHints) prior to the SavePoint
+	txHints map[string]interface{}
 }
 
 type origEntry struct {
@@ -95,6 +98,10 @@ func (d *DB) DeclareSP() error {
 	savePoint = &_savePoint{txCmdsLen: len(d.txCmds), // Record CAS Tx Ops
 		cECDLen:          len(d.cvlEditConfigData), // Record CVL Edit Ops
 		txTsOrigEntryMap: make(map[string]map[string]origEntry),
+		txHints:          make(map[string]interface{}, len(d.txHints)),
+	}
+	for k, v := range d.txHints {
+		savePoint.txHints[k] = v
 	}
 
 	glog.Infof("DeclareSP: End: %# v", savePoint)
@@ -188,6 +195,7 @@ func (d *DB) Rollback2SP() error {
 
 	// Rollback CAS Tx Operations
 	d.txCmds = d.txCmds[0:savePoint.txCmdsLen]
+	d.txHints = savePoint.txHints
 
 	// The redis CAS Tx cache needs to be rebuilt from scratch, because
 	// while reopening (and recreating) the CVL Session, there might be
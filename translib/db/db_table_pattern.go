@@ -164,8 +164,16 @@ func (d *DB) GetTablePattern(ts *TableSpec, pat Key) (Table, error) {
 			}
 			table.entry[redisKey] = value
 			keys = append(keys, d.redis2key(ts, redisKey))
+			d.recordBase(ts, keys[len(keys)-1], value)
 		}
 	}
+	if d.Opts.TrackBaseVersion {
+		redisKeys := make([]string, 0, len(table.entry))
+		for redisKey := range table.entry {
+			redisKeys = append(redisKeys, redisKey)
+		}
+		d.recordScan(ts, pat, redisKeys)
+	}
 
 GetTablePatternFoundCache:
 
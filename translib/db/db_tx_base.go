@@ -0,0 +1,353 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Azure/sonic-mgmt-common/cvl"
+	cmn "github.com/Azure/sonic-mgmt-common/cvl/common"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	"github.com/golang/glog"
+)
+
+// Base versions (Optimistic Concurrency)
+//
+// A Candidate Config DB which does not hold the ConfigDB lock records the
+// value of each key at the time it is first read or written in the
+// transaction (its base version). At commit, CheckTxConflicts compares
+// the base versions against the running config, and WATCHes the keys so
+// that the EXEC fails if they change before the commit completes.
+//
+// The key patterns read by GetKeys, GetKeysPattern, GetTable(Pattern),
+// NewScanCursor and the CVL pipeline record the keys present at the time.
+// At commit, a key added to or removed from such a pattern is a conflict
+// as well. Redis cannot WATCH a pattern; so the keys added after
+// CheckTxConflicts, and before the EXEC are not detected. The caller is
+// expected to hold the ConfigDB lock (ConfigDBTryLock) across
+// CheckTxConflicts and CommitTx.
+//
+// The lua scripts of CVL (Lookup, Count) read the DB without recording.
+// So, CheckTxConflicts validates the writes of the transaction against
+// the running config once more; a write CVL refuses now is a conflict.
+
+type txBaseEntry struct {
+	ts    *TableSpec
+	key   Key
+	value Value
+}
+
+type txScanEntry struct {
+	ts   *TableSpec
+	pat  Key
+	keys map[string]bool // Redis keys matching pat
+}
+
+// TxConflict is a key modified in the DB after the transaction read or
+// wrote it.
+type TxConflict struct {
+	Ts      *TableSpec
+	Key     Key
+	Base    Value // Value seen by the transaction (empty: did not exist)
+	Running Value // Current value in the DB (empty: deleted)
+	Pattern *Key  // Pattern read, if the key was added to or removed from it
+	Invalid error // CVL failure of the write against the running config
+}
+
+// TxConflictError is returned by CommitTx when the EXEC fails, because the
+// keys WATCHed by CheckTxConflicts were modified meanwhile.
+type TxConflictError struct {
+	Conflicts []TxConflict
+}
+
+func (e TxConflictError) Error() string {
+	return fmt.Sprintf("Transaction Failure: %d conflicting keys", len(e.Conflicts))
+}
+
+// recordBase saves the value as the base version, if the key does not have
+// one already.
+func (d *DB) recordBase(ts *TableSpec, key Key, value Value) {
+	if !d.Opts.TrackBaseVersion || d.txState == txStateNone {
+		return
+	}
+	entry := d.key2redis(ts, key)
+	if _, ok := d.txBase[entry]; ok {
+		return
+	}
+	if d.txBase == nil {
+		d.txBase = make(map[string]*txBaseEntry)
+	}
+	d.txBase[entry] = &txBaseEntry{ts: ts, key: key.Copy(), value: value.Copy()}
+}
+
+// recordBaseFromDB reads the key from the DB and saves it as the base
+// version, if the key does not have one already.
+func (d *DB) recordBaseFromDB(ts *TableSpec, key Key) error {
+	if !d.Opts.TrackBaseVersion || d.txState == txStateNone {
+		return nil
+	}
+	entry := d.key2redis(ts, key)
+	if _, ok := d.txBase[entry]; ok {
+		return nil
+	}
+	v, e := d.client.HGetAll(entry).Result()
+	if e != nil {
+		glog.Errorf("recordBaseFromDB: HGETALL %s: e: %v", entry, e)
+		return e
+	}
+	d.recordBase(ts, key, Value{Field: v})
+	return nil
+}
+
+// recordScan saves the keys matching the pattern, if the pattern has not
+// been read already.
+func (d *DB) recordScan(ts *TableSpec, pat Key, redisKeys []string) {
+	if !d.Opts.TrackBaseVersion || d.txState == txStateNone {
+		return
+	}
+	entry := d.key2redis(ts, pat)
+	if _, ok := d.txScans[entry]; ok {
+		return
+	}
+	if d.txScans == nil {
+		d.txScans = make(map[string]*txScanEntry)
+	}
+	scan := &txScanEntry{ts: ts, pat: pat.Copy(),
+		keys: make(map[string]bool, len(redisKeys))}
+	for _, k := range redisKeys {
+		scan.keys[k] = true
+	}
+	d.txScans[entry] = scan
+}
+
+// recordScanFromDB reads the keys matching the pattern from the DB, and
+// saves them, if the pattern has not been read already.
+func (d *DB) recordScanFromDB(ts *TableSpec, pat Key) error {
+	if !d.Opts.TrackBaseVersion || d.txState == txStateNone {
+		return nil
+	}
+	entry := d.key2redis(ts, pat)
+	if _, ok := d.txScans[entry]; ok {
+		return nil
+	}
+	redisKeys, e := d.client.Keys(entry).Result()
+	if e != nil {
+		glog.Errorf("recordScanFromDB: KEYS %s: e: %v", entry, e)
+		return e
+	}
+	d.recordScan(ts, pat, redisKeys)
+	return nil
+}
+
+// checkScanConflicts returns the keys added to or removed from the
+// patterns read by the transaction.
+func (d *DB) checkScanConflicts() ([]TxConflict, error) {
+	patterns := make([]string, 0, len(d.txScans))
+	for entry := range d.txScans {
+		patterns = append(patterns, entry)
+	}
+	sort.Strings(patterns)
+
+	var conflicts []TxConflict
+	for _, entry := range patterns {
+		scan := d.txScans[entry]
+		redisKeys, e := d.client.Keys(entry).Result()
+		if e != nil {
+			glog.Errorf("checkScanConflicts: KEYS %s: e: %v", entry, e)
+			return nil, e
+		}
+		running := make(map[string]bool, len(redisKeys))
+		for _, k := range redisKeys {
+			running[k] = true
+		}
+
+		var changed []string
+		for k := range running {
+			if !scan.keys[k] {
+				changed = append(changed, k)
+			}
+		}
+		for k := range scan.keys {
+			if !running[k] {
+				changed = append(changed, k)
+			}
+		}
+		sort.Strings(changed)
+
+		for _, k := range changed {
+			if _, ok := d.txBase[k]; ok {
+				continue // Value compared by CheckTxConflicts
+			}
+			c := TxConflict{Ts: scan.ts, Key: d.redis2key(scan.ts, k),
+				Pattern: &scan.pat}
+			if running[k] {
+				v, e := d.client.HGetAll(k).Result()
+				if e != nil {
+					glog.Errorf("checkScanConflicts: HGETALL %s: e: %v", k, e)
+					return nil, e
+				}
+				c.Running = Value{Field: v}
+			}
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts, nil
+}
+
+// revalidateTx validates the writes of the transaction against the running
+// config, in order, as doCVL did against the config at the time of each
+// write. Returns the first write CVL refuses now.
+func (d *DB) revalidateTx() ([]TxConflict, error) {
+	if d.Opts.DisableCVLCheck || len(d.cvlEditConfigData) == 0 {
+		return nil, nil
+	}
+
+	rdb, e := NewDB(Options{DBNo: ConfigDB, IsWriteDisabled: true})
+	if e != nil {
+		return nil, e
+	}
+	defer rdb.DeleteDB()
+
+	c, e := rdb.NewValidationSession()
+	if e != nil {
+		return nil, e
+	}
+	defer cvl.ValidationSessClose(c)
+
+	data := make([]cmn.CVLEditConfigData, 0, len(d.cvlEditConfigData))
+	for _, item := range d.cvlEditConfigData {
+		item.VType = cmn.VALIDATE_ALL
+		data = append(data, item)
+		cvlErrs, ret := c.ValidateEditConfigAll(data)
+		if ret != cvl.CVL_SUCCESS {
+			glog.Warningf("revalidateTx: %s: CVL Failure: %v", item.Key, ret)
+			var invalid error = tlerr.TranslibCVLFailure{Code: int(ret)}
+			if len(cvlErrs) != 0 {
+				invalid = newCVLFailure(cvlErrs)
+			}
+			v, e := d.client.HGetAll(item.Key).Result()
+			if e != nil {
+				glog.Errorf("revalidateTx: HGETALL %s: e: %v", item.Key, e)
+				return nil, e
+			}
+			ts, key := d.redis2ts_key(item.Key)
+			return []TxConflict{{Ts: &ts, Key: key, Running: Value{Field: v},
+				Invalid: invalid}}, nil
+		}
+		data[len(data)-1].VType = cmn.VALIDATE_NONE
+	}
+	return nil, nil
+}
+
+// baseConflicts returns the keys (entries) whose running config differs
+// from their base version.
+func (d *DB) baseConflicts(entries []string) ([]TxConflict, error) {
+	var conflicts []TxConflict
+	for _, entry := range entries {
+		base := d.txBase[entry]
+		v, e := d.client.HGetAll(entry).Result()
+		if e != nil {
+			glog.Errorf("baseConflicts: HGETALL %s: e: %v", entry, e)
+			return nil, e
+		}
+		running := Value{Field: v}
+		if !base.value.Equals(&running) {
+			conflicts = append(conflicts, TxConflict{Ts: base.ts,
+				Key: base.key, Base: base.value, Running: running})
+		}
+	}
+	return conflicts, nil
+}
+
+// execFailure returns the error for a failed EXEC. When the base versions
+// are tracked, the modified keys are returned as TxConflictError.
+func (d *DB) execFailure() error {
+	if !d.Opts.TrackBaseVersion || len(d.txBase) == 0 {
+		return tlerr.TranslibTransactionFail{}
+	}
+
+	entries := make([]string, 0, len(d.txBase))
+	for entry := range d.txBase {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+
+	conflicts, e := d.baseConflicts(entries)
+	if e != nil || len(conflicts) == 0 {
+		return tlerr.TranslibTransactionFail{}
+	}
+	glog.Warningf("execFailure: %d conflicting keys", len(conflicts))
+	return TxConflictError{Conflicts: conflicts}
+}
+
+// CheckTxConflicts WATCHes the keys which have a base version, and returns
+// those which were modified in the DB since, the keys which were added to
+// or removed from the patterns read, and the write which is not valid for
+// the running config anymore. When there are no conflicts, the keys remain
+// WATCHed for the CommitTx.
+func (d *DB) CheckTxConflicts() ([]TxConflict, error) {
+	if !d.IsOpen() {
+		return nil, ConnectionClosed
+	}
+
+	conflicts, e := d.checkScanConflicts()
+	if e != nil {
+		return nil, e
+	}
+	invalid, e := d.revalidateTx()
+	if e != nil {
+		return nil, e
+	}
+	conflicts = append(conflicts, invalid...)
+	if len(d.txBase) == 0 {
+		return conflicts, nil
+	}
+
+	entries := make([]string, 0, len(d.txBase))
+	for entry := range d.txBase {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+
+	args := make([]interface{}, 0, len(entries)+1)
+	args = append(args, "WATCH")
+	for _, entry := range entries {
+		args = append(args, entry)
+	}
+	glog.Info("CheckTxConflicts: Do: WATCH ", len(entries), " keys")
+	if _, e := d.client.Do(args...).Result(); e != nil {
+		glog.Errorf("CheckTxConflicts: WATCH e: %v", e)
+		return nil, e
+	}
+
+	baseConflicts, e := d.baseConflicts(entries)
+	if e != nil {
+		d.client.Do("UNWATCH")
+		return nil, e
+	}
+	conflicts = append(conflicts, baseConflicts...)
+
+	if len(conflicts) != 0 {
+		glog.Warningf("CheckTxConflicts: %d conflicting keys", len(conflicts))
+		d.client.Do("UNWATCH")
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,226 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func newBaseTestSessDB(t *testing.T, ts *TableSpec) *DB {
+	ccd, e := NewDB(Options{
+		DBNo:             ConfigDB,
+		IsSession:        true,
+		DisableCVLCheck:  true,
+		TrackBaseVersion: true,
+	})
+	if e != nil {
+		t.Fatalf("Session NewDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { ccd.DeleteDB() })
+	if e = ccd.StartSessTx(nil, nil); e != nil {
+		t.Fatalf("StartSessTx() fails e: %v", e)
+	}
+	return ccd
+}
+
+func TestCheckTxConflicts(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXBASE_TST_" + strconv.Itoa(os.Getpid())}
+	k1, k2, k3 := NewKey("k1"), NewKey("k2"), NewKey("k3")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+	v2 := Value{Field: map[string]string{"f2": "v2"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	// Session reads k1, creates k2 and k3
+	ccd := newBaseTestSessDB(t, ts)
+	if _, e = ccd.GetEntry(ts, *k1); e != nil {
+		t.Fatalf("GetEntry() fails e: %v", e)
+	}
+	if e = ccd.CreateEntry(ts, *k2, v2); e == nil {
+		e = ccd.CreateEntry(ts, *k3, v2)
+	}
+	if e != nil {
+		t.Fatalf("CreateEntry() fails e: %v", e)
+	}
+
+	// Running config changes k1 and k2 underneath
+	v1New := Value{Field: map[string]string{"f1": "v1new"}}
+	if e = d.SetEntry(ts, *k1, v1New); e == nil {
+		e = d.SetEntry(ts, *k2, v1)
+	}
+	if e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	conflicts, e := ccd.CheckTxConflicts()
+	if e != nil {
+		t.Fatalf("CheckTxConflicts() fails e: %v", e)
+	}
+	if len(conflicts) != 2 || !conflicts[0].Key.Equals(*k1) ||
+		!conflicts[1].Key.Equals(*k2) {
+		t.Fatalf("CheckTxConflicts() = %v; expected k1, k2", conflicts)
+	}
+	if !conflicts[0].Base.Equals(&v1) || !conflicts[0].Running.Equals(&v1New) ||
+		conflicts[1].Base.IsPopulated() {
+		t.Errorf("CheckTxConflicts() values %v", conflicts)
+	}
+	ccd.AbortSessTx()
+
+	// No conflicts: the commit goes through
+	ccd = newBaseTestSessDB(t, ts)
+	if e = ccd.ModEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("ModEntry() fails e: %v", e)
+	}
+	if conflicts, e = ccd.CheckTxConflicts(); e != nil || len(conflicts) != 0 {
+		t.Fatalf("CheckTxConflicts() = %v, %v; expected none", conflicts, e)
+	}
+	if e = ccd.CommitSessTx(); e != nil {
+		t.Fatalf("CommitSessTx() fails e: %v", e)
+	}
+	if v, _ := d.GetEntry(ts, *k1); !v.Equals(&v1) {
+		t.Errorf("GetEntry() after commit = %v; expected %v", v, v1)
+	}
+}
+
+func TestCheckTxConflicts_Pattern(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXSCAN_TST_" + strconv.Itoa(os.Getpid())}
+	k1, k2 := NewKey("k1"), NewKey("k2")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	// Session enumerates the table, and creates k3
+	ccd := newBaseTestSessDB(t, ts)
+	if keys, e := ccd.GetKeys(ts); e != nil || len(keys) != 1 {
+		t.Fatalf("GetKeys() = %v, %v; expected k1", keys, e)
+	}
+	if e = ccd.CreateEntry(ts, *NewKey("k3"), v1); e != nil {
+		t.Fatalf("CreateEntry() fails e: %v", e)
+	}
+
+	// Running config adds k2 underneath
+	if e = d.SetEntry(ts, *k2, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	conflicts, e := ccd.CheckTxConflicts()
+	if e != nil {
+		t.Fatalf("CheckTxConflicts() fails e: %v", e)
+	}
+	if len(conflicts) != 1 || !conflicts[0].Key.Equals(*k2) ||
+		conflicts[0].Pattern == nil || !conflicts[0].Running.Equals(&v1) {
+		t.Errorf("CheckTxConflicts() = %+v; expected k2 added", conflicts)
+	}
+	ccd.AbortSessTx()
+}
+
+func TestCheckTxConflicts_GetEntries(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXENTRIES_TST_" + strconv.Itoa(os.Getpid())}
+	k1, k2 := NewKey("k1"), NewKey("k2")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	// Session reads k1 and k2 (not existing)
+	ccd := newBaseTestSessDB(t, ts)
+	if _, errs := ccd.GetEntries(ts, []Key{*k1, *k2}); len(errs) != 2 || errs[0] != nil {
+		t.Fatalf("GetEntries() errs %v", errs)
+	}
+
+	// Running config deletes k1 and creates k2 underneath
+	if e = d.DeleteEntry(ts, *k1); e == nil {
+		e = d.SetEntry(ts, *k2, v1)
+	}
+	if e != nil {
+		t.Fatalf("Running config write fails e: %v", e)
+	}
+
+	conflicts, e := ccd.CheckTxConflicts()
+	if e != nil {
+		t.Fatalf("CheckTxConflicts() fails e: %v", e)
+	}
+	if len(conflicts) != 2 || !conflicts[0].Key.Equals(*k1) ||
+		!conflicts[1].Key.Equals(*k2) {
+		t.Fatalf("CheckTxConflicts() = %v; expected k1, k2", conflicts)
+	}
+	ccd.AbortSessTx()
+}
+
+func TestCommitTx_ExecConflict(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXEXEC_TST_" + strconv.Itoa(os.Getpid())}
+	k1 := NewKey("k1")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+	v2 := Value{Field: map[string]string{"f2": "v2"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+
+	ccd := newBaseTestSessDB(t, ts)
+	if e = ccd.CreateEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("CreateEntry() fails e: %v", e)
+	}
+	if conflicts, e := ccd.CheckTxConflicts(); e != nil || len(conflicts) != 0 {
+		t.Fatalf("CheckTxConflicts() = %v, %v; expected none", conflicts, e)
+	}
+
+	// k1 is created after the check, before the EXEC
+	if e = d.SetEntry(ts, *k1, v2); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	e = ccd.CommitSessTx()
+	txErr, ok := e.(TxConflictError)
+	if !ok || len(txErr.Conflicts) != 1 || !txErr.Conflicts[0].Key.Equals(*k1) {
+		t.Fatalf("CommitSessTx() = %v; expected TxConflictError for k1", e)
+	}
+	if v, _ := d.GetEntry(ts, *k1); !v.Equals(&v2) {
+		t.Errorf("GetEntry() after failed commit = %v; expected %v", v, v2)
+	}
+}
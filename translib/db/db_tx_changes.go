@@ -0,0 +1,70 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"github.com/golang/glog"
+)
+
+// TxChange is the net change of a key by the pending transaction commands.
+type TxChange struct {
+	Ts  *TableSpec
+	Key Key
+	Old Value // Empty if the key does not exist in the DB
+	New Value // Empty if the key is deleted
+}
+
+// GetTxChanges returns the pending transaction commands consolidated per
+// key, in the order the keys were first modified. The old values are read
+// from the DB; the keys which end up unchanged are skipped.
+func (d *DB) GetTxChanges() ([]TxChange, error) {
+	if !d.IsOpen() {
+		return nil, ConnectionClosed
+	}
+
+	var changes []TxChange
+	seen := make(map[string]map[string]bool)
+	for _, cmd := range d.txCmds {
+		entry := d.key2redis(cmd.ts, *cmd.key)
+		if seen[cmd.ts.Name][entry] {
+			continue
+		}
+		if seen[cmd.ts.Name] == nil {
+			seen[cmd.ts.Name] = make(map[string]bool)
+		}
+		seen[cmd.ts.Name][entry] = true
+
+		old, err := d.client.HGetAll(entry).Result()
+		if err != nil {
+			glog.Errorf("GetTxChanges: HGETALL %s: e: %v", entry, err)
+			return nil, err
+		}
+
+		c := TxChange{Ts: cmd.ts, Key: cmd.key.Copy(), Old: Value{Field: old}}
+		if v, ok := d.txTsEntryMap[cmd.ts.Name][entry]; ok {
+			c.New = v.Copy()
+		}
+		if !c.Old.Equals(&c.New) {
+			changes = append(changes, c)
+		}
+	}
+
+	return changes, nil
+}
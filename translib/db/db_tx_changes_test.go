@@ -0,0 +1,87 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestGetTxChanges(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXCHG_TST_" + strconv.Itoa(os.Getpid())}
+	k1, k2, k3 := NewKey("k1"), NewKey("k2"), NewKey("k3")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+	v2 := Value{Field: map[string]string{"f2": "v2"}}
+	v3 := Value{Field: map[string]string{"f3": "v3"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e == nil {
+		e = d.SetEntry(ts, *k2, v2)
+	}
+	if e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	ccd, e := NewDB(Options{
+		DBNo:            ConfigDB,
+		IsSession:       true,
+		DisableCVLCheck: true,
+	})
+	if e != nil {
+		t.Fatalf("Session NewDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { ccd.DeleteDB() })
+	if e = ccd.StartSessTx(nil, []*TableSpec{ts}); e != nil {
+		t.Fatalf("StartSessTx() fails e: %v", e)
+	}
+	t.Cleanup(func() { ccd.AbortSessTx() })
+
+	v1New := Value{Field: map[string]string{"f1": "v1new"}}
+	for _, op := range []func() error{
+		func() error { return ccd.ModEntry(ts, *k1, v1New) },
+		func() error { return ccd.DeleteEntry(ts, *k2) },
+		func() error { return ccd.CreateEntry(ts, *k3, v3) },
+		func() error { return ccd.DeleteEntry(ts, *k3) },
+		func() error { return ccd.ModEntry(ts, *k1, v1New) },
+	} {
+		if e = op(); e != nil {
+			t.Fatalf("Session write fails e: %v", e)
+		}
+	}
+
+	changes, e := ccd.GetTxChanges()
+	if e != nil {
+		t.Fatalf("GetTxChanges() fails e: %v", e)
+	}
+	exp := []TxChange{
+		{Ts: ts, Key: *k1, Old: v1, New: v1New},
+		{Ts: ts, Key: *k2, Old: v2, New: Value{Field: map[string]string{}}},
+	}
+	if !reflect.DeepEqual(changes, exp) {
+		t.Errorf("GetTxChanges() = %v; expected %v", changes, exp)
+	}
+}
@@ -0,0 +1,108 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"github.com/golang/glog"
+)
+
+// Names of the pending transaction command operations (TxCmd.Op)
+const (
+	TxCmdHMSet = "HMSET" // Set the fields of the key
+	TxCmdHDel  = "HDEL"  // Delete the fields of the key
+	TxCmdDel   = "DEL"   // Delete the key
+)
+
+// TxCmd is a pending transaction command, as queued by the writes.
+type TxCmd struct {
+	Op     string // TxCmdHMSet, TxCmdHDel, or TxCmdDel
+	Ts     *TableSpec
+	Key    Key
+	Value  Value // Fields to be set or deleted. Empty for TxCmdDel
+	Exists bool  // Key existed prior to this command
+}
+
+// GetTxCmds returns a copy of the pending transaction commands, in the
+// order they were queued. The Exists flag of each command is derived from
+// the fields of the key in the DB, as changed by the preceding commands on
+// the same key; an HDEL of the last field removes the key.
+func (d *DB) GetTxCmds() ([]TxCmd, error) {
+	if !d.IsOpen() {
+		return nil, ConnectionClosed
+	}
+
+	cmds := make([]TxCmd, 0, len(d.txCmds))
+	fields := make(map[string]map[string]map[string]bool)
+	for _, cmd := range d.txCmds {
+		entry := d.key2redis(cmd.ts, *cmd.key)
+		if fields[cmd.ts.Name] == nil {
+			fields[cmd.ts.Name] = make(map[string]map[string]bool)
+		}
+		f, ok := fields[cmd.ts.Name][entry]
+		if !ok {
+			names, err := d.client.HKeys(entry).Result()
+			if err != nil {
+				glog.Errorf("GetTxCmds: HKEYS %s: e: %v", entry, err)
+				return nil, err
+			}
+			f = make(map[string]bool, len(names))
+			for _, n := range names {
+				f[n] = true
+			}
+			fields[cmd.ts.Name][entry] = f
+		}
+
+		c := TxCmd{Op: getOperationName(cmd.op), Ts: cmd.ts,
+			Key: cmd.key.Copy(), Exists: len(f) != 0}
+		if cmd.value != nil {
+			c.Value = cmd.value.Copy()
+		}
+		cmds = append(cmds, c)
+
+		switch {
+		case cmd.op == txOpHMSet && cmd.value != nil:
+			for n := range cmd.value.Field {
+				f[n] = true
+			}
+		case cmd.op == txOpHDel && cmd.value != nil:
+			for n := range cmd.value.Field {
+				delete(f, n)
+			}
+		case cmd.op == txOpDel:
+			for n := range f {
+				delete(f, n)
+			}
+		}
+	}
+
+	return cmds, nil
+}
+
+// GetTxHints returns a copy of the CVL hints stored in the transaction.
+func (d *DB) GetTxHints() map[string]interface{} {
+	hints := make(map[string]interface{}, len(d.txHints)+len(d.cvlHintsB4Open))
+	for k, v := range d.cvlHintsB4Open {
+		hints[k] = v
+	}
+	for k, v := range d.txHints {
+		hints[k] = v
+	}
+	return hints
+}
@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package db
+
+import (
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestGetTxCmds(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXEXPORT_TST_" + strconv.Itoa(os.Getpid())}
+	k1, k2 := NewKey("k1"), NewKey("k2")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+	v2 := Value{Field: map[string]string{"f2": "v2"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	// Session modifies k1, creates and deletes k2
+	ccd := newBaseTestSessDB(t, ts)
+	if e = ccd.ModEntry(ts, *k1, v2); e == nil {
+		if e = ccd.CreateEntry(ts, *k2, v2); e == nil {
+			e = ccd.DeleteEntry(ts, *k2)
+		}
+	}
+	if e != nil {
+		t.Fatalf("Session write fails e: %v", e)
+	}
+
+	cmds, e := ccd.GetTxCmds()
+	if e != nil {
+		t.Fatalf("GetTxCmds() fails e: %v", e)
+	}
+	exp := []TxCmd{
+		{Op: TxCmdHMSet, Ts: ts, Key: *k1, Value: v2, Exists: true},
+		{Op: TxCmdHMSet, Ts: ts, Key: *k2, Value: v2, Exists: false},
+		{Op: TxCmdDel, Ts: ts, Key: *k2, Exists: true},
+	}
+	if !reflect.DeepEqual(cmds, exp) {
+		t.Errorf("GetTxCmds() = %+v; expected %+v", cmds, exp)
+	}
+
+	if e = ccd.AbortSessTx(); e != nil {
+		t.Fatalf("AbortSessTx() fails e: %v", e)
+	}
+	if cmds, _ = ccd.GetTxCmds(); len(cmds) != 0 {
+		t.Errorf("GetTxCmds() after AbortSessTx = %+v; expected none", cmds)
+	}
+}
+
+func TestGetTxCmds_HDelLastField(t *testing.T) {
+	ts := &TableSpec{Name: "DBTXEXPORT_TST_" + strconv.Itoa(os.Getpid())}
+	k1 := NewKey("k1")
+	v1 := Value{Field: map[string]string{"f1": "v1"}}
+	v2 := Value{Field: map[string]string{"f2": "v2"}}
+
+	d, e := newDB(ConfigDB)
+	if e != nil {
+		t.Fatalf("newDB() fails e: %v", e)
+	}
+	t.Cleanup(func() { d.DeleteTable(ts); d.DeleteDB() })
+	d.DeleteTable(ts)
+	if e = d.SetEntry(ts, *k1, v1); e != nil {
+		t.Fatalf("SetEntry() fails e: %v", e)
+	}
+
+	// Session deletes the only field of k1, and sets another one
+	ccd := newBaseTestSessDB(t, ts)
+	if e = ccd.DeleteEntryFields(ts, *k1, v1); e == nil {
+		e = ccd.ModEntry(ts, *k1, v2)
+	}
+	if e != nil {
+		t.Fatalf("Session write fails e: %v", e)
+	}
+
+	cmds, e := ccd.GetTxCmds()
+	if e != nil {
+		t.Fatalf("GetTxCmds() fails e: %v", e)
+	}
+	exp := []TxCmd{
+		{Op: TxCmdHDel, Ts: ts, Key: *k1, Value: v1, Exists: true},
+		{Op: TxCmdHMSet, Ts: ts, Key: *k1, Value: v2, Exists: false},
+	}
+	if !reflect.DeepEqual(cmds, exp) {
+		t.Errorf("GetTxCmds() = %+v; expected %+v", cmds, exp)
+	}
+	ccd.AbortSessTx()
+}
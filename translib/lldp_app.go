@@ -27,6 +27,7 @@ import (
 
 	"github.com/Azure/sonic-mgmt-common/translib/db"
 	"github.com/Azure/sonic-mgmt-common/translib/ocbinds"
+	"github.com/Azure/sonic-mgmt-common/translib/path"
 	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
 	log "github.com/golang/glog"
 	"github.com/openconfig/ygot/ygot"
@@ -45,8 +46,24 @@ const (
 	LLDP_REMOTE_REM_ID           = "lldp_rem_index"
 	LLDP_REMOTE_CHASS_ID_SUBTYPE = "lldp_rem_chassis_id_subtype"
 	LLDP_REMOTE_MAN_ADDR         = "lldp_rem_man_addr"
+
+	LLDP_CFG_TABLE      = "LLDP"
+	LLDP_CFG_PORT_TABLE = "LLDP_PORT"
+	LLDP_CFG_GLOBAL_KEY = "Global"
+	LLDP_CFG_ENABLED    = "enabled"
+	LLDP_CFG_HELLO_TIME = "hello_time"
+	LLDP_CFG_SYS_NAME   = "system_name"
+	LLDP_CFG_SYS_DESC   = "system_description"
 )
 
+/* Maps openconfig-lldp global config leaves to LLDP|Global fields */
+var lldpGlobalFieldMap = map[string]string{
+	"enabled":            LLDP_CFG_ENABLED,
+	"hello-timer":        LLDP_CFG_HELLO_TIME,
+	"system-name":        LLDP_CFG_SYS_NAME,
+	"system-description": LLDP_CFG_SYS_DESC,
+}
+
 type lldpApp struct {
 	path              *PathInfo
 	ygotRoot          *ygot.GoStruct
@@ -56,6 +73,10 @@ type lldpApp struct {
 	lldpTableMap      map[string]db.Value
 	lldpNeighTableMap map[string]map[string]string
 	lldpCapTableMap   map[string]map[string]bool
+	globalTs          *db.TableSpec
+	portTs            *db.TableSpec
+	globalCfg         db.Value
+	portCfgTableMap   map[string]db.Value
 }
 
 func init() {
@@ -83,6 +104,9 @@ func (app *lldpApp) initialize(data appData) {
 	app.lldpTableMap = make(map[string]db.Value)
 	app.lldpNeighTableMap = make(map[string]map[string]string)
 	app.lldpCapTableMap = make(map[string]map[string]bool)
+	app.globalTs = &db.TableSpec{Name: LLDP_CFG_TABLE}
+	app.portTs = &db.TableSpec{Name: LLDP_CFG_PORT_TABLE}
+	app.portCfgTableMap = make(map[string]db.Value)
 }
 
 func (app *lldpApp) getAppRootObject() *ocbinds.OpenconfigLldp_Lldp {
@@ -91,39 +115,23 @@ func (app *lldpApp) getAppRootObject() *ocbinds.OpenconfigLldp_Lldp {
 }
 
 func (app *lldpApp) translateCreate(d *db.DB) ([]db.WatchKeys, error) {
-	var err error
-	var keys []db.WatchKeys
 	log.Info("translateCreate:lldp:path =", app.path)
-
-	err = errors.New("Not implemented")
-	return keys, err
+	return nil, app.translateCRUCommon(d)
 }
 
 func (app *lldpApp) translateUpdate(d *db.DB) ([]db.WatchKeys, error) {
-	var err error
-	var keys []db.WatchKeys
 	log.Info("translateUpdate:lldp:path =", app.path)
-
-	err = errors.New("Not implemented")
-	return keys, err
+	return nil, app.translateCRUCommon(d)
 }
 
 func (app *lldpApp) translateReplace(d *db.DB) ([]db.WatchKeys, error) {
-	var err error
-	var keys []db.WatchKeys
 	log.Info("translateReplace:lldp:path =", app.path)
-
-	err = errors.New("Not implemented")
-	return keys, err
+	return nil, app.translateCRUCommon(d)
 }
 
 func (app *lldpApp) translateDelete(d *db.DB) ([]db.WatchKeys, error) {
-	var err error
-	var keys []db.WatchKeys
 	log.Info("translateDelete:lldp:path =", app.path)
-
-	err = errors.New("Not implemented")
-	return keys, err
+	return nil, nil
 }
 
 func (app *lldpApp) translateGet(dbs [db.MaxDB]*db.DB) error {
@@ -139,44 +147,234 @@ func (app *lldpApp) translateAction(dbs [db.MaxDB]*db.DB) error {
 }
 
 func (app *lldpApp) translateSubscribe(req translateSubRequest) (translateSubResponse, error) {
-	return emptySubscribeResponse(req.path)
+	ymap := yangMapTree{
+		mapFunc: app.translateSubscribeGlobal,
+		subtree: map[string]*yangMapTree{
+			"interfaces/interface": {
+				mapFunc: app.translateSubscribeIntf,
+				subtree: map[string]*yangMapTree{
+					"neighbors/neighbor": {
+						mapFunc: app.translateSubscribeNeighbor,
+					},
+				},
+			},
+		}}
+
+	nb := notificationInfoBuilder{
+		pathInfo: NewPathInfo(req.path),
+		yangMap:  ymap,
+	}
+	return nb.Build()
 }
 
-func (app *lldpApp) processSubscribe(req processSubRequest) (processSubResponse, error) {
-	return processSubResponse{}, tlerr.New("not implemented")
+func (app *lldpApp) translateSubscribeGlobal(nb *notificationInfoBuilder) error {
+	nb.New()
+	nb.Table(db.ConfigDB, LLDP_CFG_TABLE).Key(LLDP_CFG_GLOBAL_KEY)
+	for _, prefix := range []string{"config", "state"} {
+		if nb.SetFieldPrefix(prefix) {
+			nb.Field("enabled", LLDP_CFG_ENABLED)
+			nb.Field("hello-timer", LLDP_CFG_HELLO_TIME)
+			nb.Field("system-name", LLDP_CFG_SYS_NAME)
+			nb.Field("system-description", LLDP_CFG_SYS_DESC)
+		}
+	}
+	return nil
 }
 
-func (app *lldpApp) processCreate(d *db.DB) (SetResponse, error) {
-	var err error
+func (app *lldpApp) translateSubscribeIntf(nb *notificationInfoBuilder) error {
+	ifName := nb.pathInfo.StringVar("name", "*")
 
-	err = errors.New("Not implemented")
-	var resp SetResponse
+	nb.New().PathKey("name", ifName)
+	nb.Table(db.ConfigDB, LLDP_CFG_PORT_TABLE).Key(ifName)
+	for _, prefix := range []string{"config", "state"} {
+		if nb.SetFieldPrefix(prefix) {
+			nb.Field("enabled", LLDP_CFG_ENABLED)
+		}
+	}
+	return nil
+}
 
-	return resp, err
+func (app *lldpApp) translateSubscribeNeighbor(nb *notificationInfoBuilder) error {
+	ifName := nb.pathInfo.StringVar("name", "*")
+
+	// Neighbor id is the local interface name; see getLldpNeighInfoFromInternalMap
+	nb.New().PathKey("id", ifName)
+	nb.Table(db.ApplDB, "LLDP_ENTRY_TABLE").Key(ifName)
+	return nil
 }
 
-func (app *lldpApp) processUpdate(d *db.DB) (SetResponse, error) {
-	var err error
+func (app *lldpApp) processSubscribe(req processSubRequest) (processSubResponse, error) {
+	resp := processSubResponse{
+		path: req.path,
+	}
 
-	err = errors.New("Not implemented")
-	var resp SetResponse
+	switch req.table.Name {
+	case LLDP_CFG_TABLE:
+	case LLDP_CFG_PORT_TABLE:
+		path.SetKeyAt(resp.path, 2, "name", req.key.Get(0))
+	case "LLDP_ENTRY_TABLE":
+		path.SetKeyAt(resp.path, 2, "name", req.key.Get(0))
+		path.SetKeyAt(resp.path, 4, "id", req.key.Get(0))
+	default:
+		return resp, tlerr.New("Unknown table: %s", req.table.Name)
+	}
 
-	return resp, err
+	return resp, nil
+}
+
+func (app *lldpApp) processCreate(d *db.DB) (SetResponse, error) {
+	return app.processCRUCommon(d, CREATE)
+}
+
+func (app *lldpApp) processUpdate(d *db.DB) (SetResponse, error) {
+	return app.processCRUCommon(d, UPDATE)
 }
 
 func (app *lldpApp) processReplace(d *db.DB) (SetResponse, error) {
-	var err error
+	return app.processCRUCommon(d, REPLACE)
+}
+
+func (app *lldpApp) processDelete(d *db.DB) (SetResponse, error) {
 	var resp SetResponse
-	err = errors.New("Not implemented")
 
+	targetUriPath, err := getYangPathFromUri(app.path.Path)
+	if err != nil {
+		return resp, err
+	}
+	log.Info("processDelete:lldp:targetUriPath = ", targetUriPath)
+
+	switch {
+	case targetUriPath == "/openconfig-lldp:lldp":
+		err = app.deleteGlobalFields(d, lldpGlobalFields()...)
+		if err == nil {
+			err = d.DeleteTable(app.portTs)
+		}
+	case targetUriPath == "/openconfig-lldp:lldp/config":
+		err = app.deleteGlobalFields(d, lldpGlobalFields()...)
+	case strings.HasPrefix(targetUriPath, "/openconfig-lldp:lldp/config/"):
+		leaf := strings.TrimPrefix(targetUriPath, "/openconfig-lldp:lldp/config/")
+		field, ok := lldpGlobalFieldMap[leaf]
+		if !ok {
+			return resp, tlerr.NotSupported("Delete of %s not supported", leaf)
+		}
+		err = app.deleteGlobalFields(d, field)
+	case targetUriPath == "/openconfig-lldp:lldp/interfaces":
+		err = d.DeleteTable(app.portTs)
+	case strings.HasPrefix(targetUriPath, "/openconfig-lldp:lldp/interfaces/interface"):
+		ifName := app.path.Var("name")
+		if len(ifName) == 0 {
+			err = d.DeleteTable(app.portTs)
+		} else {
+			err = d.DeleteEntry(app.portTs, asKey(ifName))
+		}
+	default:
+		return resp, tlerr.NotSupported("Delete not supported for %s", targetUriPath)
+	}
+
+	if err != nil {
+		log.Error(err)
+		resp = SetResponse{ErrSrc: AppErr}
+	}
 	return resp, err
 }
 
-func (app *lldpApp) processDelete(d *db.DB) (SetResponse, error) {
+/* Deletes the given fields from the LLDP global entry, skipping fields not present in DB */
+func (app *lldpApp) deleteGlobalFields(d *db.DB, fields ...string) error {
+	entry, err := d.GetEntry(app.globalTs, asKey(LLDP_CFG_GLOBAL_KEY))
+	if err != nil {
+		log.Info("LLDP global entry not found, nothing to delete")
+		return nil
+	}
+	delValue := db.Value{Field: make(map[string]string)}
+	for _, field := range fields {
+		if entry.Has(field) {
+			delValue.Set(field, "")
+		}
+	}
+	if !delValue.IsPopulated() {
+		return nil
+	}
+	return d.DeleteEntryFields(app.globalTs, asKey(LLDP_CFG_GLOBAL_KEY), delValue)
+}
+
+func lldpGlobalFields() []string {
+	fields := make([]string, 0, len(lldpGlobalFieldMap))
+	for _, field := range lldpGlobalFieldMap {
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+/* Converts the LLDP global and interface config in the request into DB values */
+func (app *lldpApp) translateCRUCommon(d *db.DB) error {
+	lldpObj := app.getAppRootObject()
+	if lldpObj == nil {
+		return nil
+	}
+
+	if cfg := lldpObj.Config; cfg != nil {
+		if cfg.ChassisId != nil || cfg.ChassisIdType != ocbinds.OpenconfigLldp_ChassisIdType_UNSET ||
+			len(cfg.SuppressTlvAdvertisement) != 0 {
+			return tlerr.NotSupported("LLDP chassis-id and suppress-tlv-advertisement configuration not supported")
+		}
+		app.globalCfg = db.Value{Field: make(map[string]string)}
+		if cfg.Enabled != nil {
+			app.globalCfg.Set(LLDP_CFG_ENABLED, strconv.FormatBool(*cfg.Enabled))
+		}
+		if cfg.HelloTimer != nil {
+			app.globalCfg.Set(LLDP_CFG_HELLO_TIME, strconv.FormatUint(*cfg.HelloTimer, 10))
+		}
+		if cfg.SystemName != nil {
+			app.globalCfg.Set(LLDP_CFG_SYS_NAME, *cfg.SystemName)
+		}
+		if cfg.SystemDescription != nil {
+			app.globalCfg.Set(LLDP_CFG_SYS_DESC, *cfg.SystemDescription)
+		}
+	}
+
+	if lldpObj.Interfaces != nil {
+		for ifName, intf := range lldpObj.Interfaces.Interface {
+			portCfg := db.Value{Field: make(map[string]string)}
+			if intf.Config != nil && intf.Config.Enabled != nil {
+				portCfg.Set(LLDP_CFG_ENABLED, strconv.FormatBool(*intf.Config.Enabled))
+			} else {
+				portCfg.Set("NULL", "NULL")
+			}
+			app.portCfgTableMap[ifName] = portCfg
+		}
+	}
+
+	return nil
+}
+
+func (app *lldpApp) processCRUCommon(d *db.DB, opcode int) (SetResponse, error) {
 	var err error
-	err = errors.New("Not implemented")
 	var resp SetResponse
 
+	if app.globalCfg.IsPopulated() {
+		if opcode == REPLACE {
+			err = app.deleteGlobalFields(d, lldpGlobalFields()...)
+		}
+		if err == nil {
+			err = d.ModEntry(app.globalTs, asKey(LLDP_CFG_GLOBAL_KEY), app.globalCfg)
+		}
+	}
+
+	for ifName, portCfg := range app.portCfgTableMap {
+		if err != nil {
+			break
+		}
+		if opcode == REPLACE {
+			err = d.SetEntry(app.portTs, asKey(ifName), portCfg)
+		} else {
+			err = d.ModEntry(app.portTs, asKey(ifName), portCfg)
+		}
+	}
+
+	if err != nil {
+		log.Error(err)
+		resp = SetResponse{ErrSrc: AppErr}
+	}
 	return resp, err
 }
 
@@ -195,7 +393,14 @@ func (app *lldpApp) processGet(dbs [db.MaxDB]*db.DB, fmtType TranslibFmtType) (G
 	log.Info("lldp processGet")
 	log.Info("targetUriPath: ", targetUriPath)
 
-	if targetUriPath == "/openconfig-lldp:lldp/interfaces" {
+	cfgDb := dbs[db.ConfigDB]
+	if targetUriPath == "/openconfig-lldp:lldp" || isSubtreeRequest(targetUriPath, "/openconfig-lldp:lldp/config") ||
+		isSubtreeRequest(targetUriPath, "/openconfig-lldp:lldp/state") {
+		ygot.BuildEmptyTree(lldpIntfObj)
+		app.getLldpGlobalCfgFromDB(cfgDb, lldpIntfObj)
+	}
+
+	if targetUriPath == "/openconfig-lldp:lldp" || targetUriPath == "/openconfig-lldp:lldp/interfaces" {
 		log.Info("Requesting interfaces")
 		app.getLldpInfoFromDB(nil)
 		ygot.BuildEmptyTree(lldpIntfObj)
@@ -209,8 +414,22 @@ func (app *lldpApp) processGet(dbs [db.MaxDB]*db.DB, fmtType TranslibFmtType) (G
 			}
 			ygot.BuildEmptyTree(oneIfInfo)
 			app.getLldpNeighInfoFromInternalMap(&ifname, oneIfInfo)
+			app.getLldpIntfCfgFromDB(cfgDb, ifname, oneIfInfo)
 		}
-	} else if targetUriPath == "/openconfig-lldp:lldp/interfaces/interface" {
+		portKeys, _ := cfgDb.GetKeys(app.portTs)
+		for _, key := range portKeys {
+			ifname := key.Get(0)
+			if _, ok := ifInfo.Interface[ifname]; ok {
+				continue
+			}
+			oneIfInfo, err := ifInfo.NewInterface(ifname)
+			if err != nil {
+				return GetResponse{Payload: payload, ErrSrc: AppErr}, err
+			}
+			ygot.BuildEmptyTree(oneIfInfo)
+			app.getLldpIntfCfgFromDB(cfgDb, ifname, oneIfInfo)
+		}
+	} else if isSubtreeRequest(targetUriPath, "/openconfig-lldp:lldp/interfaces/interface") {
 		intfObj := lldpIntfObj.Interfaces
 		ygot.BuildEmptyTree(intfObj)
 		if intfObj.Interface != nil && len(intfObj.Interface) > 0 {
@@ -219,7 +438,10 @@ func (app *lldpApp) processGet(dbs [db.MaxDB]*db.DB, fmtType TranslibFmtType) (G
 				app.getLldpInfoFromDB(&ifname)
 				ifInfo := intfObj.Interface[ifname]
 				ygot.BuildEmptyTree(ifInfo)
-				app.getLldpNeighInfoFromInternalMap(&ifname, ifInfo)
+				if _, ok := app.lldpNeighTableMap[ifname]; ok {
+					app.getLldpNeighInfoFromInternalMap(&ifname, ifInfo)
+				}
+				app.getLldpIntfCfgFromDB(cfgDb, ifname, ifInfo)
 			}
 		} else {
 			log.Info("No data")
@@ -321,6 +543,54 @@ func (app *lldpApp) getLldpNeighInfoFromInternalMap(ifName *string, ifInfo *ocbi
 	}
 }
 
+/** Helper function to populate LLDP global config and state from configDB **/
+func (app *lldpApp) getLldpGlobalCfgFromDB(d *db.DB, lldpObj *ocbinds.OpenconfigLldp_Lldp) {
+	entry, err := d.GetEntry(app.globalTs, asKey(LLDP_CFG_GLOBAL_KEY))
+	if err != nil {
+		log.Info("LLDP global config not found")
+		return
+	}
+
+	if entry.Has(LLDP_CFG_ENABLED) {
+		enabled, _ := strconv.ParseBool(entry.Get(LLDP_CFG_ENABLED))
+		lldpObj.Config.Enabled = &enabled
+		lldpObj.State.Enabled = &enabled
+	}
+	if entry.Has(LLDP_CFG_HELLO_TIME) {
+		if helloTime, err := strconv.ParseUint(entry.Get(LLDP_CFG_HELLO_TIME), 10, 64); err == nil {
+			lldpObj.Config.HelloTimer = &helloTime
+			lldpObj.State.HelloTimer = &helloTime
+		}
+	}
+	if entry.Has(LLDP_CFG_SYS_NAME) {
+		sysName := entry.Get(LLDP_CFG_SYS_NAME)
+		lldpObj.Config.SystemName = &sysName
+		lldpObj.State.SystemName = &sysName
+	}
+	if entry.Has(LLDP_CFG_SYS_DESC) {
+		sysDesc := entry.Get(LLDP_CFG_SYS_DESC)
+		lldpObj.Config.SystemDescription = &sysDesc
+		lldpObj.State.SystemDescription = &sysDesc
+	}
+}
+
+/** Helper function to populate LLDP interface config and state from configDB **/
+func (app *lldpApp) getLldpIntfCfgFromDB(d *db.DB, ifName string, ifInfo *ocbinds.OpenconfigLldp_Lldp_Interfaces_Interface) {
+	entry, err := d.GetEntry(app.portTs, asKey(ifName))
+	if err != nil {
+		return
+	}
+
+	name := ifName
+	ifInfo.Config.Name = &name
+	ifInfo.State.Name = &name
+	if entry.Has(LLDP_CFG_ENABLED) {
+		enabled, _ := strconv.ParseBool(entry.Get(LLDP_CFG_ENABLED))
+		ifInfo.Config.Enabled = &enabled
+		ifInfo.State.Enabled = &enabled
+	}
+}
+
 /** Helper function to get information from applDB **/
 func (app *lldpApp) getLldpInfoFromDB(ifname *string) {
 
@@ -0,0 +1,87 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package translib
+
+import (
+	"errors"
+	"testing"
+
+	db "github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func init() {
+	addCleanupFunc("LLDP", clearLldpDataFromDb)
+}
+
+// This will test PATCH and GET on /openconfig-lldp:lldp/config
+func Test_LldpApp_GlobalConfig(t *testing.T) {
+	url := "/openconfig-lldp:lldp/config"
+
+	t.Run("Update_Global_Config", processSetRequest(url, lldpGlobalConfigJsonRequest, "PATCH", false))
+	t.Run("Get_Global_Config", processGetRequest(url, lldpGlobalConfigJsonResponse, false))
+
+	t.Run("Update_Hello_Timer", processSetRequest(url+"/hello-timer", "{\"openconfig-lldp:hello-timer\": 60}", "PATCH", false))
+	t.Run("Get_Hello_Timer", processGetRequest(url+"/hello-timer", "{\"openconfig-lldp:hello-timer\": \"60\"}", false))
+
+	t.Run("Delete_System_Name", processDeleteRequest(url+"/system-name"))
+	t.Run("Get_Global_Config_After_Delete", processGetRequest(url, lldpGlobalConfigAfterDeleteJsonResponse, false))
+
+	t.Run("Delete_Global_Config", processDeleteRequest(url))
+	t.Run("Verify_Global_Config_Delete", processGetRequest(url, emptyJson, false))
+}
+
+// This will test PATCH and GET on /openconfig-lldp:lldp/interfaces
+func Test_LldpApp_IntfConfig(t *testing.T) {
+	url := "/openconfig-lldp:lldp/interfaces/interface"
+
+	t.Run("Update_Intf_Config", processSetRequest(url, lldpIntfConfigJsonRequest, "PATCH", false))
+	t.Run("Get_Intf_Config", processGetRequest(url+"[name=Ethernet0]/config", lldpIntfConfigJsonResponse, false))
+
+	t.Run("Delete_Intf_Config", processDeleteRequest(url+"[name=Ethernet0]"))
+}
+
+func clearLldpDataFromDb() error {
+	d := getConfigDb()
+	if d == nil {
+		return errors.New("Failed to connect to config Db")
+	}
+	defer d.DeleteDB()
+	if err := d.DeleteTable(&db.TableSpec{Name: "LLDP_PORT"}); err != nil {
+		return errors.New("Failed to delete LLDP_PORT Table")
+	}
+	if err := d.DeleteTable(&db.TableSpec{Name: "LLDP"}); err != nil {
+		return errors.New("Failed to delete LLDP Table")
+	}
+	return nil
+}
+
+/***************************************************************************/
+///////////                  JSON Data for Tests              ///////////////
+/***************************************************************************/
+
+var lldpGlobalConfigJsonRequest string = "{\"openconfig-lldp:config\": {\"enabled\": true, \"hello-timer\": 30, \"system-name\": \"sonic-switch\", \"system-description\": \"SONiC managed switch\"}}"
+
+var lldpGlobalConfigJsonResponse string = "{\"openconfig-lldp:config\": {\"enabled\": true, \"hello-timer\": \"30\", \"system-description\": \"SONiC managed switch\", \"system-name\": \"sonic-switch\"}}"
+
+var lldpGlobalConfigAfterDeleteJsonResponse string = "{\"openconfig-lldp:config\": {\"enabled\": true, \"hello-timer\": \"60\", \"system-description\": \"SONiC managed switch\"}}"
+
+var lldpIntfConfigJsonRequest string = "{\"openconfig-lldp:interface\": [{\"name\": \"Ethernet0\", \"config\": {\"name\": \"Ethernet0\", \"enabled\": false}}]}"
+
+var lldpIntfConfigJsonResponse string = "{\"openconfig-lldp:config\": {\"enabled\": false, \"name\": \"Ethernet0\"}}"
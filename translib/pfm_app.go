@@ -22,6 +22,7 @@ import (
 	"errors"
 	"github.com/Azure/sonic-mgmt-common/translib/db"
 	"github.com/Azure/sonic-mgmt-common/translib/ocbinds"
+	"github.com/Azure/sonic-mgmt-common/translib/path"
 	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
 	log "github.com/golang/glog"
 	"github.com/openconfig/ygot/ygot"
@@ -29,6 +30,37 @@ import (
 	"strconv"
 )
 
+const (
+	PFM_EEPROM_COMPONENT = "System Eeprom"
+
+	/* STATE_DB platform tables */
+	PFM_EEPROM_INFO_TBL      = "EEPROM_INFO"
+	PFM_FAN_INFO_TBL         = "FAN_INFO"
+	PFM_PSU_INFO_TBL         = "PSU_INFO"
+	PFM_TEMPERATURE_INFO_TBL = "TEMPERATURE_INFO"
+	PFM_TRANSCEIVER_INFO_TBL = "TRANSCEIVER_INFO"
+	PFM_TRANSCEIVER_DOM_TBL  = "TRANSCEIVER_DOM_SENSOR"
+)
+
+/*
+*
+Describes a component type populated from a STATE_DB table, keyed by
+component name
+*/
+type pfmComponentInfo struct {
+	table    string
+	compType ocbinds.E_OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT
+	fillFunc func(app *PlatformApp, d *db.DB, name string, entry db.Value,
+		state *ocbinds.OpenconfigPlatform_Components_Component_State)
+}
+
+var pfmComponentInfoList = []pfmComponentInfo{
+	{PFM_FAN_INFO_TBL, ocbinds.OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_FAN, (*PlatformApp).fillFanState},
+	{PFM_PSU_INFO_TBL, ocbinds.OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_POWER_SUPPLY, (*PlatformApp).fillPsuState},
+	{PFM_TEMPERATURE_INFO_TBL, ocbinds.OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_SENSOR, (*PlatformApp).fillSensorState},
+	{PFM_TRANSCEIVER_INFO_TBL, ocbinds.OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_TRANSCEIVER, (*PlatformApp).fillTransceiverState},
+}
+
 type PlatformApp struct {
 	path        *PathInfo
 	reqData     []byte
@@ -63,7 +95,7 @@ func (app *PlatformApp) initialize(data appData) {
 	app.reqData = data.payload
 	app.ygotRoot = data.ygotRoot
 	app.ygotTarget = data.ygotTarget
-	app.eepromTs = &db.TableSpec{Name: "EEPROM_INFO"}
+	app.eepromTs = &db.TableSpec{Name: PFM_EEPROM_INFO_TBL}
 
 }
 
@@ -78,11 +110,90 @@ func (app *PlatformApp) translateAction(dbs [db.MaxDB]*db.DB) error {
 }
 
 func (app *PlatformApp) translateSubscribe(req translateSubRequest) (translateSubResponse, error) {
-	return emptySubscribeResponse(req.path)
+	ymap := yangMapTree{
+		subtree: map[string]*yangMapTree{
+			"component": {
+				mapFunc: app.translateSubscribeComponent,
+			},
+		}}
+
+	nb := notificationInfoBuilder{
+		pathInfo: NewPathInfo(req.path),
+		yangMap:  ymap,
+	}
+	return nb.Build()
+}
+
+func (app *PlatformApp) translateSubscribeComponent(nb *notificationInfoBuilder) error {
+	compName := nb.pathInfo.StringVar("name", "*")
+
+	// System eeprom contents are static; sample only
+	if compName == "*" || compName == PFM_EEPROM_COMPONENT {
+		nb.New().PathKey("name", PFM_EEPROM_COMPONENT)
+		nb.Table(db.StateDB, PFM_EEPROM_INFO_TBL).Key("*").OnChange(false)
+		if compName == PFM_EEPROM_COMPONENT {
+			return nil
+		}
+	}
+
+	// Component name is the table key; only the table holding the
+	// component will ever notify for a specific name.
+	nb.New().PathKey("name", compName)
+	nb.Table(db.StateDB, PFM_FAN_INFO_TBL).Key(compName)
+	if nb.SetFieldPrefix("state") {
+		nb.Field("empty", "presence")
+		nb.Field("oper-status", "status")
+		nb.Field("part-no", "model")
+		nb.Field("serial-no", "serial")
+	}
+
+	nb.New().PathKey("name", compName)
+	nb.Table(db.StateDB, PFM_PSU_INFO_TBL).Key(compName)
+	if nb.SetFieldPrefix("state") {
+		nb.Field("empty", "presence")
+		nb.Field("oper-status", "status")
+		nb.Field("part-no", "model")
+		nb.Field("serial-no", "serial")
+		nb.Field("hardware-version", "revision")
+	}
+
+	// Sensor readings change continuously; sample only
+	nb.New().PathKey("name", compName)
+	nb.Table(db.StateDB, PFM_TEMPERATURE_INFO_TBL).Key(compName).OnChange(false)
+	if nb.SetFieldPrefix("state/temperature") {
+		nb.Field("instant", "temperature")
+		nb.Field("alarm-status", "warning_status")
+		nb.Field("alarm-threshold", "high_threshold")
+	}
+
+	// Transceiver presence is indicated by the TRANSCEIVER_INFO entry itself
+	nb.New().PathKey("name", compName)
+	nb.Table(db.StateDB, PFM_TRANSCEIVER_INFO_TBL).Key(compName)
+	if nb.SetFieldPrefix("state") {
+		nb.Field("description", "type")
+		nb.Field("mfg-name", "manufacturer")
+		nb.Field("part-no", "model")
+		nb.Field("serial-no", "serial")
+		nb.Field("hardware-version", "hardware_rev")
+	}
+	return nil
 }
 
 func (app *PlatformApp) processSubscribe(req processSubRequest) (processSubResponse, error) {
-	return processSubResponse{}, tlerr.New("not implemented")
+	resp := processSubResponse{
+		path: req.path,
+	}
+
+	switch req.table.Name {
+	case PFM_EEPROM_INFO_TBL:
+		path.SetKeyAt(resp.path, 1, "name", PFM_EEPROM_COMPONENT)
+	case PFM_FAN_INFO_TBL, PFM_PSU_INFO_TBL, PFM_TEMPERATURE_INFO_TBL, PFM_TRANSCEIVER_INFO_TBL:
+		path.SetKeyAt(resp.path, 1, "name", req.key.Get(0))
+	default:
+		return resp, tlerr.New("Unknown table: %s", req.table.Name)
+	}
+
+	return resp, nil
 }
 
 func (app *PlatformApp) translateCreate(d *db.DB) ([]db.WatchKeys, error) {
@@ -191,8 +302,14 @@ func (app *PlatformApp) processGet(dbs [db.MaxDB]*db.DB, fmtType TranslibFmtType
 
 	var err error
 
-	if isSubtreeRequest(targetUriPath, "/openconfig-platform:components") {
+	compName := app.path.Var("name")
+	if compName != "" && compName != PFM_EEPROM_COMPONENT {
+		err = app.doGetComponent(stateDb, compName)
+	} else if isSubtreeRequest(targetUriPath, "/openconfig-platform:components") {
 		err = app.doGetSysEeprom()
+		if err == nil && compName == "" {
+			err = app.doGetAllComponents(stateDb)
+		}
 	} else {
 		err = errors.New("Not supported component")
 	}
@@ -497,3 +614,202 @@ func (app *PlatformApp) doGetSysEeprom() error {
 	}
 	return err
 }
+
+/*
+*
+Populates all FAN, POWER_SUPPLY, SENSOR and TRANSCEIVER components
+*/
+func (app *PlatformApp) doGetAllComponents(d *db.DB) error {
+	pf_cpts := app.getAppRootObject()
+
+	for _, info := range pfmComponentInfoList {
+		ts := &db.TableSpec{Name: info.table}
+		keys, err := d.GetKeys(ts)
+		if err != nil {
+			log.Infof("%s table get failed: %v", info.table, err)
+			continue
+		}
+
+		for _, key := range keys {
+			name := key.Get(0)
+			entry, err := d.GetEntry(ts, key)
+			if err != nil {
+				continue
+			}
+			pf_comp, ok := pf_cpts.Component[name]
+			if !ok {
+				pf_comp, err = pf_cpts.NewComponent(name)
+				if err != nil {
+					return err
+				}
+			}
+			ygot.BuildEmptyTree(pf_comp)
+			app.fillComponentState(d, info, name, entry, pf_comp.State)
+		}
+	}
+	return nil
+}
+
+/*
+*
+Populates a single FAN, POWER_SUPPLY, SENSOR or TRANSCEIVER component
+*/
+func (app *PlatformApp) doGetComponent(d *db.DB, compName string) error {
+	pf_cpts := app.getAppRootObject()
+
+	for _, info := range pfmComponentInfoList {
+		entry, err := d.GetEntry(&db.TableSpec{Name: info.table}, asKey(compName))
+		if err != nil {
+			continue
+		}
+
+		pf_comp := pf_cpts.Component[compName]
+		if pf_comp == nil {
+			if pf_comp, err = pf_cpts.NewComponent(compName); err != nil {
+				return err
+			}
+		}
+		ygot.BuildEmptyTree(pf_comp)
+		app.fillComponentState(d, info, compName, entry, pf_comp.State)
+		return nil
+	}
+
+	return tlerr.NotFound("Component %s not found", compName)
+}
+
+func (app *PlatformApp) fillComponentState(d *db.DB, info pfmComponentInfo, name string, entry db.Value,
+	state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+
+	compName := name
+	state.Name = &compName
+	state.Type = &ocbinds.OpenconfigPlatform_Components_Component_State_Type_Union_E_OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT{
+		E_OpenconfigPlatformTypes_OPENCONFIG_HARDWARE_COMPONENT: info.compType,
+	}
+	info.fillFunc(app, d, name, entry, state)
+}
+
+func (app *PlatformApp) fillFanState(d *db.DB, name string, entry db.Value,
+	state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+
+	removable := true
+	state.Removable = &removable
+	fillPresenceAndStatus(entry, state)
+	if entry.Has("model") {
+		model := entry.Get("model")
+		state.PartNo = &model
+	}
+	if entry.Has("serial") {
+		serial := entry.Get("serial")
+		state.SerialNo = &serial
+	}
+	if entry.Has("drawer_name") {
+		drawer := entry.Get("drawer_name")
+		state.Location = &drawer
+	}
+}
+
+func (app *PlatformApp) fillPsuState(d *db.DB, name string, entry db.Value,
+	state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+
+	removable := true
+	state.Removable = &removable
+	fillPresenceAndStatus(entry, state)
+	if entry.Has("model") {
+		model := entry.Get("model")
+		state.PartNo = &model
+	}
+	if entry.Has("serial") {
+		serial := entry.Get("serial")
+		state.SerialNo = &serial
+	}
+	if entry.Has("revision") {
+		revision := entry.Get("revision")
+		state.HardwareVersion = &revision
+	}
+	if temp, err := strconv.ParseFloat(entry.Get("temp"), 64); err == nil {
+		state.Temperature.Instant = &temp
+	}
+	if power, err := strconv.ParseFloat(entry.Get("power"), 64); err == nil {
+		usedPower := uint32(power)
+		state.UsedPower = &usedPower
+	}
+}
+
+func (app *PlatformApp) fillSensorState(d *db.DB, name string, entry db.Value,
+	state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+
+	removable := false
+	state.Removable = &removable
+	state.OperStatus = ocbinds.OpenconfigPlatformTypes_COMPONENT_OPER_STATUS_ACTIVE
+	if temp, err := strconv.ParseFloat(entry.Get("temperature"), 64); err == nil {
+		state.Temperature.Instant = &temp
+	}
+	if warning, err := strconv.ParseBool(entry.Get("warning_status")); err == nil {
+		state.Temperature.AlarmStatus = &warning
+	}
+	if threshold, err := strconv.ParseFloat(entry.Get("high_threshold"), 64); err == nil {
+		alarmThreshold := uint32(threshold)
+		state.Temperature.AlarmThreshold = &alarmThreshold
+	}
+}
+
+func (app *PlatformApp) fillTransceiverState(d *db.DB, name string, entry db.Value,
+	state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+
+	// TRANSCEIVER_INFO entry exists only while the module is inserted
+	empty := false
+	removable := true
+	state.Empty = &empty
+	state.Removable = &removable
+	state.OperStatus = ocbinds.OpenconfigPlatformTypes_COMPONENT_OPER_STATUS_ACTIVE
+	if entry.Has("type") {
+		xcvrType := entry.Get("type")
+		state.Description = &xcvrType
+	}
+	if entry.Has("manufacturer") {
+		mfgName := entry.Get("manufacturer")
+		state.MfgName = &mfgName
+	}
+	if entry.Has("model") {
+		model := entry.Get("model")
+		state.PartNo = &model
+	}
+	if entry.Has("serial") {
+		serial := entry.Get("serial")
+		state.SerialNo = &serial
+	}
+	if entry.Has("hardware_rev") {
+		hwRev := entry.Get("hardware_rev")
+		state.HardwareVersion = &hwRev
+	}
+
+	dom, err := d.GetEntry(&db.TableSpec{Name: PFM_TRANSCEIVER_DOM_TBL}, asKey(name))
+	if err != nil {
+		return
+	}
+	if temp, err := strconv.ParseFloat(dom.Get("temperature"), 64); err == nil {
+		state.Temperature.Instant = &temp
+	}
+}
+
+/*
+*
+Fills empty and oper-status from the presence and status fields
+*/
+func fillPresenceAndStatus(entry db.Value, state *ocbinds.OpenconfigPlatform_Components_Component_State) {
+	if presence, err := strconv.ParseBool(entry.Get("presence")); err == nil {
+		empty := !presence
+		state.Empty = &empty
+		if !presence {
+			state.OperStatus = ocbinds.OpenconfigPlatformTypes_COMPONENT_OPER_STATUS_INACTIVE
+			return
+		}
+	}
+	if status, err := strconv.ParseBool(entry.Get("status")); err == nil {
+		if status {
+			state.OperStatus = ocbinds.OpenconfigPlatformTypes_COMPONENT_OPER_STATUS_ACTIVE
+		} else {
+			state.OperStatus = ocbinds.OpenconfigPlatformTypes_COMPONENT_OPER_STATUS_INACTIVE
+		}
+	}
+}
@@ -74,6 +74,26 @@ func Test_PfmApp_TopLevelPath(t *testing.T) {
 	t.Run("Get_Full_Pfm_Tree_Top_Level", processGetRequest(url, bulkPfmShowAllJsonResponse, false))
 }
 
+// This will test GET on FAN, POWER_SUPPLY components
+func Test_PfmApp_FanPsuComponents(t *testing.T) {
+	if err := createPfmComponentDb(); err != nil {
+		fmt.Printf("Failed to add Platform component Data to Db: %v", err)
+	}
+
+	url := "/openconfig-platform:components/component[name=FAN 1]"
+	t.Run("Get_Fan_Component", processGetRequest(url, pfmFanJsonResponse, false))
+
+	url = "/openconfig-platform:components/component[name=PSU 1]/state/oper-status"
+	t.Run("Get_Psu_Oper_Status", processGetRequest(url, pfmPsuOperStatusJsonResponse, false))
+
+	url = "/openconfig-platform:components/component[name=FAN 9]"
+	t.Run("Get_Unknown_Component", processGetRequest(url, "", true))
+
+	if err := clearPfmDataFromDb(); err != nil {
+		fmt.Printf("Failed to remove Platform Data from Db: %v", err)
+	}
+}
+
 // THis will delete Platform Table from DB
 func clearPfmDataFromDb() error {
 	var err error
@@ -88,9 +108,39 @@ func clearPfmDataFromDb() error {
 		err = errors.New("Failed to delete Eeprom Table")
 		return err
 	}
+	for _, tbl := range []string{"FAN_INFO", "PSU_INFO"} {
+		if err = d.DeleteTable(&db.TableSpec{Name: tbl}); err != nil {
+			err = errors.New("Failed to delete " + tbl + " Table")
+			return err
+		}
+	}
 	return err
 }
 
+func createPfmComponentDb() error {
+	d := getStateDB()
+	if d == nil {
+		return errors.New("Failed to connect to state Db")
+	}
+
+	fanEntry := db.Value{Field: map[string]string{
+		"presence":    "True",
+		"status":      "True",
+		"model":       "FAN-M1",
+		"serial":      "FS123",
+		"drawer_name": "FanTray1",
+	}}
+	if err := d.SetEntry(&db.TableSpec{Name: "FAN_INFO"}, db.Key{Comp: []string{"FAN 1"}}, fanEntry); err != nil {
+		return err
+	}
+
+	psuEntry := db.Value{Field: map[string]string{
+		"presence": "True",
+		"status":   "False",
+	}}
+	return d.SetEntry(&db.TableSpec{Name: "PSU_INFO"}, db.Key{Comp: []string{"PSU 1"}}, psuEntry)
+}
+
 func createPfmFactoryDb() error {
 	var err error
 	eepromTable := db.TableSpec{Name: "EEPROM_INFO"}
@@ -133,3 +183,7 @@ func getStateDB() *db.DB {
 var bulkPfmShowDefaultResponse string = "{\"openconfig-platform:components\":{\"component\":[{\"name\":\"System Eeprom\",\"state\":{\"empty\":false,\"location\":\"Slot 1\",\"name\":\"System Eeprom\",\"oper-status\":\"openconfig-platform-types:ACTIVE\",\"removable\":false}}]}}"
 
 var bulkPfmShowAllJsonResponse string = "{\"openconfig-platform:components\":{\"component\":[{\"name\":\"System Eeprom\",\"state\":{\"description\":\"" + TEST_PLATFORM_NAME + "\",\"empty\":false,\"id\":\"" + TEST_PRODUCT_NAME + "\",\"location\":\"Slot 1\",\"mfg-name\":\"" + TEST_MANUF_NAME + "\",\"name\":\"System Eeprom\",\"oper-status\":\"openconfig-platform-types:ACTIVE\",\"part-no\":\"" + TEST_PART_NUMBER + "\",\"removable\":false,\"serial-no\":\"" + TEST_SERVICE_TAG + "\"}}]}}"
+
+var pfmFanJsonResponse string = "{\"openconfig-platform:component\":[{\"name\":\"FAN 1\",\"state\":{\"empty\":false,\"location\":\"FanTray1\",\"name\":\"FAN 1\",\"oper-status\":\"openconfig-platform-types:ACTIVE\",\"part-no\":\"FAN-M1\",\"removable\":true,\"serial-no\":\"FS123\",\"type\":\"openconfig-platform-types:FAN\"}}]}"
+
+var pfmPsuOperStatusJsonResponse string = "{\"openconfig-platform:oper-status\":\"openconfig-platform-types:INACTIVE\"}"
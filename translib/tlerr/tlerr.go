@@ -71,6 +71,7 @@ func (e TranslibRedisClientEntryNotExist) Error() string {
 type TranslibCVLFailure struct {
 	Code         int
 	CVLErrorInfo cvl.CVLErrorInfo
+	Errors       []cvl.CVLErrorInfo // All failures, when more than one is reported
 }
 
 func (e TranslibCVLFailure) Error() string {
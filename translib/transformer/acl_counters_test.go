@@ -0,0 +1,82 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+//go:build testapp
+// +build testapp
+
+package transformer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+)
+
+func Test_acl_counters_and_clear(t *testing.T) {
+	const oid = "oid:0x9000000000001"
+	const entryUrl = "/openconfig-acl:acl/acl-sets/acl-set[name=MyACL1][type=ACL_IPV4]/acl-entries/acl-entry[sequence-id=1]/state"
+	var pre_req_map, expected_map, cleanuptbl map[string]interface{}
+	var url string
+
+	pre_req_map = map[string]interface{}{
+		"ACL_TABLE": map[string]interface{}{"MyACL1_ACL_IPV4": map[string]interface{}{"type": "L3", "stage": "INGRESS"}},
+		"ACL_RULE": map[string]interface{}{"MyACL1_ACL_IPV4|RULE_1": map[string]interface{}{"PRIORITY": "65535",
+			"PACKET_ACTION": "FORWARD", "IP_TYPE": "IPV4", "SRC_IP": "10.1.1.1/32", "DST_IP": "20.1.1.1/32"}}}
+	loadDB(db.ConfigDB, pre_req_map)
+	rclientDBNum[db.CountersDB].HSet("ACL_COUNTER_RULE_MAP", "MyACL1_ACL_IPV4:RULE_1", oid)
+	pre_req_map = map[string]interface{}{"COUNTERS": map[string]interface{}{oid: map[string]interface{}{
+		"SAI_ACL_COUNTER_ATTR_PACKETS": "100", "SAI_ACL_COUNTER_ATTR_BYTES": "6400"}}}
+	loadDB(db.CountersDB, pre_req_map)
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n+++++++++++++ Performing Get on ACL entry counters ++++++++++++")
+	expected_get_json := "{\"openconfig-acl:state\":{\"matched-octets\":\"6400\",\"matched-packets\":\"100\",\"sequence-id\":1}}"
+	t.Run("Test get on ACL entry counters", processGetRequest(entryUrl, nil, expected_get_json, false))
+	t.Log("\n\n+++++++++++++ Done Performing Get on ACL entry counters ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing clear ACL counters ++++++++++++")
+	url = "/sonic-acl:clear-acl-counters"
+	t.Run("Test clear ACL counters", processActionRequest(url, "{\"sonic-acl:input\":{\"aclname\":\"MyACL1_ACL_IPV4\"}}", "POST", "", "", false, false))
+	time.Sleep(1 * time.Second)
+	expected_map = map[string]interface{}{"ACL_COUNTERS_BASELINE": map[string]interface{}{oid: map[string]interface{}{
+		"SAI_ACL_COUNTER_ATTR_PACKETS": "100", "SAI_ACL_COUNTER_ATTR_BYTES": "6400"}}}
+	t.Run("Verify ACL counters baseline", verifyDbResult(rclientDBNum[db.CountersDB], "ACL_COUNTERS_BASELINE:"+oid, expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing clear ACL counters ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Get on ACL entry counters after clear ++++++++++++")
+	pre_req_map = map[string]interface{}{"COUNTERS": map[string]interface{}{oid: map[string]interface{}{
+		"SAI_ACL_COUNTER_ATTR_PACKETS": "150", "SAI_ACL_COUNTER_ATTR_BYTES": "9600"}}}
+	loadDB(db.CountersDB, pre_req_map)
+	time.Sleep(1 * time.Second)
+	expected_get_json = "{\"openconfig-acl:state\":{\"matched-octets\":\"3200\",\"matched-packets\":\"50\",\"sequence-id\":1}}"
+	t.Run("Test get on ACL entry counters after clear", processGetRequest(entryUrl, nil, expected_get_json, false))
+	t.Log("\n\n+++++++++++++ Done Performing Get on ACL entry counters after clear ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing clear counters on unknown ACL ++++++++++++")
+	url = "/sonic-acl:clear-acl-counters"
+	t.Run("Test clear counters on unknown ACL", processActionRequest(url, "{\"sonic-acl:input\":{\"aclname\":\"NoSuchACL_ACL_IPV4\"}}", "POST", "", "", false, true))
+	t.Log("\n\n+++++++++++++ Done Performing clear counters on unknown ACL ++++++++++++")
+
+	rclientDBNum[db.CountersDB].HDel("ACL_COUNTER_RULE_MAP", "MyACL1_ACL_IPV4:RULE_1")
+	cleanuptbl = map[string]interface{}{"COUNTERS": map[string]interface{}{oid: ""}, "ACL_COUNTERS_BASELINE": map[string]interface{}{oid: ""}}
+	unloadDB(db.CountersDB, cleanuptbl)
+	cleanuptbl = map[string]interface{}{"ACL_RULE": map[string]interface{}{"MyACL1_ACL_IPV4|RULE_1": ""}, "ACL_TABLE": map[string]interface{}{"MyACL1_ACL_IPV4": ""}}
+	unloadDB(db.ConfigDB, cleanuptbl)
+}
@@ -282,8 +282,8 @@ func Test_openconfig_subintf_ipv4(t *testing.T) {
 
 	t.Log("\n\n--- Negative test: Verify IPv4 address at incorrect subinterfaces/subinterface[index=1] level ---")
 	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=1]"
-	expected_get_json = "{}"
-	t.Run("Negative test: Test Get IPv4 address at incorrect subinterface[index=1]", processGetRequest(url, nil, expected_get_json, false))
+	expected_err_not_found := tlerr.NotFoundError{Format: "Resource not found"}
+	t.Run("Negative test: Test Get IPv4 address at incorrect subinterface[index=1]", processGetRequest(url, nil, "", true, expected_err_not_found))
 	time.Sleep(1 * time.Second)
 
 	t.Log("\n\n--- Delete IPv4 address at subinterfaces/subinterface[index=0] level ---")
@@ -534,3 +534,186 @@ func Test_openconfig_subintf_ipv6(t *testing.T) {
 
 	t.Log("\n\n+++++++++++++ DONE ENABLING AND DISABLING IPV6 LINK LOCAL ON SUBINTERFACES  ++++++++++++")
 }
+
+func Test_openconfig_loopback_intf(t *testing.T) {
+	var url, url_input_body_json string
+
+	t.Log("\n\n+++++++++++++ CONFIGURING LOOPBACK INTERFACE ++++++++++++")
+	t.Log("\n\n--- PATCH Loopback interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Loopback10]"
+	url_input_body_json = "{\"openconfig-interfaces:interface\":[{\"name\":\"Loopback10\",\"config\":{\"name\":\"Loopback10\"}}]}"
+	t.Run("Test PATCH on Loopback interface", processSetRequest(url, url_input_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify Loopback interface in CONFIG_DB ---")
+	expected_map := map[string]interface{}{"LOOPBACK_INTERFACE": map[string]interface{}{"Loopback10": map[string]interface{}{"NULL": "NULL"}}}
+	t.Run("Verify Loopback interface creation", verifyDbResult(rclient, "LOOPBACK_INTERFACE|Loopback10", expected_map, false))
+
+	t.Log("\n\n--- PATCH IPv4 address on Loopback interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Loopback10]/subinterfaces/subinterface[index=0]/openconfig-if-ip:ipv4/addresses"
+	url_input_body_json = "{\"openconfig-if-ip:addresses\": {\"address\": [{\"ip\": \"10.10.10.10\", \"openconfig-if-ip:config\": {\"ip\": \"10.10.10.10\", \"prefix-length\": 32}}]}}"
+	t.Run("Test PATCH IPv4 address on Loopback interface", processSetRequest(url, url_input_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify IPv4 address on Loopback interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Loopback10]/subinterfaces/subinterface[index=0]/openconfig-if-ip:ipv4/addresses"
+	expected_get_json := "{\"openconfig-if-ip:addresses\": {\"address\": [{\"config\": {\"ip\": \"10.10.10.10\", \"prefix-length\": 32}, \"ip\": \"10.10.10.10\"}]}}"
+	t.Run("Test GET IPv4 address on Loopback interface", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Negative test: PATCH mtu on Loopback interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Loopback10]/config/mtu"
+	url_input_body_json = "{\"openconfig-interfaces:mtu\": 9000}"
+	err_str := "MTU configuration not supported for Loopback interface: Loopback10"
+	expected_err := tlerr.NotSupportedError{Format: err_str}
+	t.Run("Test PATCH mtu on Loopback interface", processSetRequest(url, url_input_body_json, "PATCH", true, expected_err))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Negative test: PATCH Loopback interface with invalid name ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=LoopbackX]"
+	url_input_body_json = "{\"openconfig-interfaces:interface\":[{\"name\":\"LoopbackX\",\"config\":{\"name\":\"LoopbackX\"}}]}"
+	t.Run("Test PATCH Loopback interface with invalid name", processSetRequest(url, url_input_body_json, "PATCH", true))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- DELETE Loopback interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Loopback10]"
+	t.Run("Test DELETE on Loopback interface", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify DELETE Loopback interface ---")
+	expected_map = map[string]interface{}{}
+	t.Run("Verify Loopback interface deletion", verifyDbResult(rclient, "LOOPBACK_INTERFACE|Loopback10", expected_map, false))
+	t.Run("Verify Loopback interface IP deletion", verifyDbResult(rclient, "LOOPBACK_INTERFACE|Loopback10|10.10.10.10/32", expected_map, false))
+
+	t.Log("\n\n+++++++++++++ DONE CONFIGURING LOOPBACK INTERFACE ++++++++++++")
+}
+
+func Test_openconfig_vlan_intf(t *testing.T) {
+	var url, url_input_body_json string
+
+	t.Log("\n\n+++++++++++++ CONFIGURING VLAN INTERFACE ++++++++++++")
+	t.Log("\n\n--- PATCH Vlan interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Vlan100]"
+	url_input_body_json = "{\"openconfig-interfaces:interface\":[{\"name\":\"Vlan100\",\"config\":{\"name\":\"Vlan100\",\"mtu\":9000,\"enabled\":true}}]}"
+	t.Run("Test PATCH on Vlan interface", processSetRequest(url, url_input_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify Vlan interface in CONFIG_DB ---")
+	expected_map := map[string]interface{}{"VLAN": map[string]interface{}{"Vlan100": map[string]interface{}{"vlanid": "100", "mtu": "9000", "admin_status": "up"}}}
+	t.Run("Verify Vlan interface creation", verifyDbResult(rclient, "VLAN|Vlan100", expected_map, false))
+
+	t.Log("\n\n--- PATCH IPv4 address on Vlan interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Vlan100]/subinterfaces/subinterface[index=0]/openconfig-if-ip:ipv4/addresses"
+	url_input_body_json = "{\"openconfig-if-ip:addresses\": {\"address\": [{\"ip\": \"20.20.20.1\", \"openconfig-if-ip:config\": {\"ip\": \"20.20.20.1\", \"prefix-length\": 24}}]}}"
+	t.Run("Test PATCH IPv4 address on Vlan interface", processSetRequest(url, url_input_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify IPv4 address on Vlan interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Vlan100]/subinterfaces/subinterface[index=0]/openconfig-if-ip:ipv4/addresses"
+	expected_get_json := "{\"openconfig-if-ip:addresses\": {\"address\": [{\"config\": {\"ip\": \"20.20.20.1\", \"prefix-length\": 24}, \"ip\": \"20.20.20.1\"}]}}"
+	t.Run("Test GET IPv4 address on Vlan interface", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	pre_req_map := map[string]interface{}{"VLAN_TABLE": map[string]interface{}{"Vlan100": map[string]interface{}{"admin_status": "up", "mtu": "9000"}}}
+	loadDB(db.ApplDB, pre_req_map)
+	rclientDBNum[db.CountersDB].HSet("COUNTERS_RIF_NAME_MAP", "Vlan100", "oid:0x6000000000a01")
+	pre_req_cntr_map := map[string]interface{}{
+		"COUNTERS": map[string]interface{}{"oid:0x6000000000a01": map[string]interface{}{
+			"SAI_ROUTER_INTERFACE_STAT_IN_OCTETS": "1000", "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "10",
+			"SAI_ROUTER_INTERFACE_STAT_IN_ERROR_PACKETS": "0", "SAI_ROUTER_INTERFACE_STAT_OUT_OCTETS": "2000",
+			"SAI_ROUTER_INTERFACE_STAT_OUT_PACKETS": "20", "SAI_ROUTER_INTERFACE_STAT_OUT_ERROR_PACKETS": "0"}}}
+	loadDB(db.CountersDB, pre_req_cntr_map)
+
+	t.Log("\n\n--- Verify Vlan interface state ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Vlan100]/state"
+	expected_get_json = "{\"openconfig-interfaces:state\": {\"admin-status\": \"UP\", \"counters\": {\"in-errors\": \"0\", \"in-octets\": \"1000\", \"in-pkts\": \"10\", \"out-errors\": \"0\", \"out-octets\": \"2000\", \"out-pkts\": \"20\"}, \"enabled\": true, \"mtu\": 9000, \"name\": \"Vlan100\"}}"
+	t.Run("Test GET on Vlan interface state", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- DELETE Vlan interface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Vlan100]"
+	t.Run("Test DELETE on Vlan interface", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify DELETE Vlan interface ---")
+	expected_map = map[string]interface{}{}
+	t.Run("Verify Vlan deletion", verifyDbResult(rclient, "VLAN|Vlan100", expected_map, false))
+	t.Run("Verify Vlan interface deletion", verifyDbResult(rclient, "VLAN_INTERFACE|Vlan100", expected_map, false))
+	t.Run("Verify Vlan interface IP deletion", verifyDbResult(rclient, "VLAN_INTERFACE|Vlan100|20.20.20.1/24", expected_map, false))
+
+	unloadDB(db.ApplDB, pre_req_map)
+	unloadDB(db.CountersDB, pre_req_cntr_map)
+	rclientDBNum[db.CountersDB].HDel("COUNTERS_RIF_NAME_MAP", "Vlan100")
+	t.Log("\n\n+++++++++++++ DONE CONFIGURING VLAN INTERFACE ++++++++++++")
+}
+
+func Test_openconfig_vlan_subintf(t *testing.T) {
+	var url, url_input_body_json string
+
+	t.Log("\n\n+++++++++++++ CONFIGURING VLAN SUBINTERFACE ++++++++++++")
+	t.Log("\n\n--- PATCH VLAN subinterface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces"
+	url_input_body_json = "{\"openconfig-interfaces:subinterfaces\": {\"subinterface\": [{\"index\": 100, \"config\": {\"index\": 100, \"enabled\": true}, \"openconfig-vlan:vlan\": {\"match\": {\"single-tagged\": {\"config\": {\"vlan-id\": 100}}}}, \"openconfig-if-ip:ipv4\": {\"config\": {\"mtu\": 9000}, \"addresses\": {\"address\": [{\"ip\": \"30.30.30.1\", \"config\": {\"ip\": \"30.30.30.1\", \"prefix-length\": 24}}]}}}]}}"
+	t.Run("Test PATCH on VLAN subinterface", processSetRequest(url, url_input_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify VLAN subinterface in CONFIG_DB ---")
+	expected_map := map[string]interface{}{"VLAN_SUB_INTERFACE": map[string]interface{}{"Ethernet0.100": map[string]interface{}{"admin_status": "up", "vlan": "100", "mtu": "9000"}}}
+	t.Run("Verify VLAN subinterface creation", verifyDbResult(rclient, "VLAN_SUB_INTERFACE|Ethernet0.100", expected_map, false))
+	expected_map = map[string]interface{}{"VLAN_SUB_INTERFACE": map[string]interface{}{"Ethernet0.100|30.30.30.1/24": map[string]interface{}{"family": "IPv4"}}}
+	t.Run("Verify VLAN subinterface IP creation", verifyDbResult(rclient, "VLAN_SUB_INTERFACE|Ethernet0.100|30.30.30.1/24", expected_map, false))
+
+	t.Log("\n\n--- Verify VLAN subinterface config ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=100]/config"
+	expected_get_json := "{\"openconfig-interfaces:config\": {\"enabled\": true, \"index\": 100}}"
+	t.Run("Test GET on VLAN subinterface config", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=100]/openconfig-vlan:vlan/match/single-tagged/config"
+	expected_get_json = "{\"openconfig-vlan:config\": {\"vlan-id\": 100}}"
+	t.Run("Test GET on VLAN subinterface vlan config", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=100]/openconfig-if-ip:ipv4/addresses"
+	expected_get_json = "{\"openconfig-if-ip:addresses\": {\"address\": [{\"config\": {\"ip\": \"30.30.30.1\", \"prefix-length\": 24}, \"ip\": \"30.30.30.1\"}]}}"
+	t.Run("Test GET on VLAN subinterface IPv4 address", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	pre_req_map := map[string]interface{}{"INTF_TABLE": map[string]interface{}{"Ethernet0.100": map[string]interface{}{"admin_status": "up", "mtu": "9000", "vlan": "100"}}}
+	loadDB(db.ApplDB, pre_req_map)
+	rclientDBNum[db.CountersDB].HSet("COUNTERS_RIF_NAME_MAP", "Ethernet0.100", "oid:0x6000000000b01")
+	pre_req_cntr_map := map[string]interface{}{
+		"COUNTERS": map[string]interface{}{"oid:0x6000000000b01": map[string]interface{}{
+			"SAI_ROUTER_INTERFACE_STAT_IN_OCTETS": "3000", "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS": "30",
+			"SAI_ROUTER_INTERFACE_STAT_IN_ERROR_PACKETS": "1", "SAI_ROUTER_INTERFACE_STAT_OUT_OCTETS": "4000",
+			"SAI_ROUTER_INTERFACE_STAT_OUT_PACKETS": "40", "SAI_ROUTER_INTERFACE_STAT_OUT_ERROR_PACKETS": "2"}}}
+	loadDB(db.CountersDB, pre_req_cntr_map)
+
+	t.Log("\n\n--- Verify VLAN subinterface state ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=100]/state"
+	expected_get_json = "{\"openconfig-interfaces:state\": {\"admin-status\": \"UP\", \"counters\": {\"in-errors\": \"1\", \"in-octets\": \"3000\", \"in-pkts\": \"30\", \"out-errors\": \"2\", \"out-octets\": \"4000\", \"out-pkts\": \"40\"}, \"enabled\": true, \"index\": 100}}"
+	t.Run("Test GET on VLAN subinterface state", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Negative test: PATCH vlan-id on subinterface 0 ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=0]/openconfig-vlan:vlan/match/single-tagged/config/vlan-id"
+	url_input_body_json = "{\"openconfig-vlan:vlan-id\": 200}"
+	expected_err := tlerr.NotSupportedError{Format: "VLAN id can be configured only on subinterfaces with non-zero index"}
+	t.Run("Test PATCH vlan-id on subinterface 0", processSetRequest(url, url_input_body_json, "PATCH", true, expected_err))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- DELETE VLAN subinterface ---")
+	url = "/openconfig-interfaces:interfaces/interface[name=Ethernet0]/subinterfaces/subinterface[index=100]"
+	t.Run("Test DELETE on VLAN subinterface", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+
+	t.Log("\n\n--- Verify DELETE VLAN subinterface ---")
+	expected_map = map[string]interface{}{}
+	t.Run("Verify VLAN subinterface deletion", verifyDbResult(rclient, "VLAN_SUB_INTERFACE|Ethernet0.100", expected_map, false))
+	t.Run("Verify VLAN subinterface IP deletion", verifyDbResult(rclient, "VLAN_SUB_INTERFACE|Ethernet0.100|30.30.30.1/24", expected_map, false))
+
+	unloadDB(db.ApplDB, pre_req_map)
+	unloadDB(db.CountersDB, pre_req_cntr_map)
+	rclientDBNum[db.CountersDB].HDel("COUNTERS_RIF_NAME_MAP", "Ethernet0.100")
+	t.Log("\n\n+++++++++++++ DONE CONFIGURING VLAN SUBINTERFACE ++++++++++++")
+}
@@ -0,0 +1,232 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+//go:build testapp
+// +build testapp
+
+package transformer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+)
+
+func Test_openconfig_system_config(t *testing.T) {
+	var pre_req_map, expected_map, cleanuptbl map[string]interface{}
+	var url, url_body_json string
+
+	t.Log("\n\n+++++++++++++ Performing Set on system config ++++++++++++")
+	pre_req_map = map[string]interface{}{"DEVICE_METADATA": map[string]interface{}{"localhost": map[string]interface{}{"hwsku": "Force10-S6000", "hostname": "sonic"}}}
+	loadDB(db.ConfigDB, pre_req_map)
+	time.Sleep(1 * time.Second)
+	url = "/openconfig-system:system/config"
+	url_body_json = "{\"openconfig-system:config\":{\"hostname\":\"leaf1\",\"domain-name\":\"example.com\"}}"
+	expected_map = map[string]interface{}{"DEVICE_METADATA": map[string]interface{}{"localhost": map[string]interface{}{"hwsku": "Force10-S6000", "hostname": "leaf1", "domain_name": "example.com"}}}
+	t.Run("Test set on system config", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on system config", verifyDbResult(rclient, "DEVICE_METADATA|localhost", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on system config ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Get on system state ++++++++++++")
+	url = "/openconfig-system:system/state"
+	expected_get_json := "{\"openconfig-system:state\":{\"domain-name\":\"example.com\",\"hostname\":\"leaf1\"}}"
+	t.Run("Test get on system state", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+	t.Log("\n\n+++++++++++++ Done Performing Get on system state ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Delete on system domain-name ++++++++++++")
+	url = "/openconfig-system:system/config/domain-name"
+	expected_map = map[string]interface{}{"DEVICE_METADATA": map[string]interface{}{"localhost": map[string]interface{}{"hwsku": "Force10-S6000", "hostname": "leaf1"}}}
+	t.Run("Test delete on system domain-name", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify delete on system domain-name", verifyDbResult(rclient, "DEVICE_METADATA|localhost", expected_map, false))
+	cleanuptbl = map[string]interface{}{"DEVICE_METADATA": map[string]interface{}{"localhost": ""}}
+	unloadDB(db.ConfigDB, cleanuptbl)
+	time.Sleep(1 * time.Second)
+	t.Log("\n\n+++++++++++++ Done Performing Delete on system domain-name ++++++++++++")
+}
+
+func Test_openconfig_system_dns_ntp_logging(t *testing.T) {
+	var expected_map, cleanuptbl map[string]interface{}
+	var url, url_body_json string
+
+	t.Log("\n\n+++++++++++++ Performing Set on DNS server ++++++++++++")
+	url = "/openconfig-system:system/dns/servers"
+	url_body_json = "{\"openconfig-system:servers\":{\"server\":[{\"address\":\"10.1.1.1\",\"config\":{\"address\":\"10.1.1.1\"}}]}}"
+	expected_map = map[string]interface{}{"DNS_NAMESERVER": map[string]interface{}{"10.1.1.1": map[string]interface{}{"NULL": "NULL"}}}
+	t.Run("Test set on DNS server", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on DNS server", verifyDbResult(rclient, "DNS_NAMESERVER|10.1.1.1", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on DNS server ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on NTP server ++++++++++++")
+	url = "/openconfig-system:system/ntp/servers"
+	url_body_json = "{\"openconfig-system:servers\":{\"server\":[{\"address\":\"10.2.2.2\",\"config\":{\"address\":\"10.2.2.2\",\"version\":4,\"association-type\":\"SERVER\",\"iburst\":true}}]}}"
+	expected_map = map[string]interface{}{"NTP_SERVER": map[string]interface{}{"10.2.2.2": map[string]interface{}{"version": "4", "association_type": "server", "iburst": "on"}}}
+	t.Run("Test set on NTP server", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on NTP server", verifyDbResult(rclient, "NTP_SERVER|10.2.2.2", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on NTP server ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Get on NTP server state ++++++++++++")
+	url = "/openconfig-system:system/ntp/servers/server[address=10.2.2.2]/state"
+	expected_get_json := "{\"openconfig-system:state\":{\"address\":\"10.2.2.2\",\"association-type\":\"SERVER\",\"iburst\":true,\"version\":4}}"
+	t.Run("Test get on NTP server state", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+	t.Log("\n\n+++++++++++++ Done Performing Get on NTP server state ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on syslog remote server ++++++++++++")
+	url = "/openconfig-system:system/logging/remote-servers"
+	url_body_json = "{\"openconfig-system:remote-servers\":{\"remote-server\":[{\"host\":\"10.3.3.3\",\"config\":{\"host\":\"10.3.3.3\",\"source-address\":\"10.0.0.1\",\"remote-port\":5514}}]}}"
+	expected_map = map[string]interface{}{"SYSLOG_SERVER": map[string]interface{}{"10.3.3.3": map[string]interface{}{"source": "10.0.0.1", "port": "5514"}}}
+	t.Run("Test set on syslog remote server", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on syslog remote server", verifyDbResult(rclient, "SYSLOG_SERVER|10.3.3.3", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on syslog remote server ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Delete on DNS, NTP and syslog servers ++++++++++++")
+	t.Run("Test delete on DNS server", processDeleteRequest("/openconfig-system:system/dns/servers/server[address=10.1.1.1]", false))
+	t.Run("Test delete on NTP server", processDeleteRequest("/openconfig-system:system/ntp/servers/server[address=10.2.2.2]", false))
+	t.Run("Test delete on syslog remote server", processDeleteRequest("/openconfig-system:system/logging/remote-servers/remote-server[host=10.3.3.3]", false))
+	time.Sleep(1 * time.Second)
+	expected_map = map[string]interface{}{}
+	t.Run("Verify delete on DNS server", verifyDbResult(rclient, "DNS_NAMESERVER|10.1.1.1", expected_map, false))
+	t.Run("Verify delete on NTP server", verifyDbResult(rclient, "NTP_SERVER|10.2.2.2", expected_map, false))
+	t.Run("Verify delete on syslog remote server", verifyDbResult(rclient, "SYSLOG_SERVER|10.3.3.3", expected_map, false))
+	cleanuptbl = map[string]interface{}{"DNS_NAMESERVER": map[string]interface{}{"10.1.1.1": ""},
+		"NTP_SERVER":    map[string]interface{}{"10.2.2.2": ""},
+		"SYSLOG_SERVER": map[string]interface{}{"10.3.3.3": ""}}
+	unloadDB(db.ConfigDB, cleanuptbl)
+	t.Log("\n\n+++++++++++++ Done Performing Delete on DNS, NTP and syslog servers ++++++++++++")
+}
+
+func Test_openconfig_system_aaa(t *testing.T) {
+	var expected_map, cleanuptbl map[string]interface{}
+	var url, url_body_json string
+
+	t.Log("\n\n+++++++++++++ Performing Set on AAA authentication methods ++++++++++++")
+	url = "/openconfig-system:system/aaa/authentication/config"
+	url_body_json = "{\"openconfig-system:config\":{\"authentication-method\":[\"openconfig-aaa-types:TACACS_ALL\",\"openconfig-aaa-types:LOCAL\"]}}"
+	expected_map = map[string]interface{}{"AAA": map[string]interface{}{"authentication": map[string]interface{}{"login": "tacacs+,local"}}}
+	t.Run("Test set on AAA authentication methods", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on AAA authentication methods", verifyDbResult(rclient, "AAA|authentication", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on AAA authentication methods ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on TACACS server ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups"
+	url_body_json = "{\"openconfig-system:server-groups\":{\"server-group\":[{\"name\":\"TACACS\",\"config\":{\"name\":\"TACACS\",\"type\":\"openconfig-aaa:TACACS\"},\"servers\":{\"server\":[{\"address\":\"10.4.4.4\",\"config\":{\"address\":\"10.4.4.4\",\"timeout\":10},\"tacacs\":{\"config\":{\"port\":4949,\"secret-key\":\"secret1\"}}}]}}]}}"
+	expected_map = map[string]interface{}{"TACPLUS_SERVER": map[string]interface{}{"10.4.4.4": map[string]interface{}{"timeout": "10", "tcp_port": "4949", "passkey": "secret1"}}}
+	t.Run("Test set on TACACS server", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify set on TACACS server", verifyDbResult(rclient, "TACPLUS_SERVER|10.4.4.4", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on TACACS server ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Get on TACACS server with masked secret key ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=TACACS]/servers/server[address=10.4.4.4]/tacacs/state"
+	expected_get_json := "{\"openconfig-system:state\":{\"port\":4949,\"secret-key\":\"********\"}}"
+	t.Run("Test get on TACACS server state", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+	t.Log("\n\n+++++++++++++ Done Performing Get on TACACS server with masked secret key ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on RADIUS server in TACACS group ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=TACACS]/servers/server[address=10.5.5.5]/radius/config"
+	url_body_json = "{\"openconfig-system:config\":{\"auth-port\":1812}}"
+	expected_err := tlerr.InvalidArgsError{Format: "RADIUS configuration not allowed in server group TACACS"}
+	t.Run("Test set on RADIUS server in TACACS group", processSetRequest(url, url_body_json, "PATCH", true, expected_err))
+	t.Log("\n\n+++++++++++++ Done Performing Set on RADIUS server in TACACS group ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on TACACS and RADIUS global settings ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups"
+	url_body_json = "{\"openconfig-system:server-groups\":{\"server-group\":[{\"name\":\"TACACS\",\"config\":{\"name\":\"TACACS\",\"openconfig-aaa-ext:secret-key\":\"tacsecret\",\"openconfig-aaa-ext:timeout\":7,\"openconfig-aaa-ext:auth-type\":\"login\"}},{\"name\":\"RADIUS\",\"config\":{\"name\":\"RADIUS\",\"openconfig-aaa-ext:secret-key\":\"radsecret\",\"openconfig-aaa-ext:timeout\":9,\"openconfig-aaa-ext:auth-type\":\"chap\"}}]}}"
+	t.Run("Test set on TACACS and RADIUS global settings", processSetRequest(url, url_body_json, "PATCH", false, nil))
+	time.Sleep(1 * time.Second)
+	expected_map = map[string]interface{}{"TACPLUS": map[string]interface{}{"global": map[string]interface{}{"passkey": "tacsecret", "timeout": "7", "auth_type": "login"}}}
+	t.Run("Verify set on TACACS global settings", verifyDbResult(rclient, "TACPLUS|global", expected_map, false))
+	expected_map = map[string]interface{}{"RADIUS": map[string]interface{}{"global": map[string]interface{}{"passkey": "radsecret", "timeout": "9", "auth_type": "chap"}}}
+	t.Run("Verify set on RADIUS global settings", verifyDbResult(rclient, "RADIUS|global", expected_map, false))
+	t.Log("\n\n+++++++++++++ Done Performing Set on TACACS and RADIUS global settings ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Get on TACACS and RADIUS global settings ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=TACACS]/config"
+	expected_get_json = "{\"openconfig-system:config\":{\"name\":\"TACACS\",\"type\":\"openconfig-aaa:TACACS\",\"openconfig-aaa-ext:secret-key\":\"********\",\"openconfig-aaa-ext:timeout\":7,\"openconfig-aaa-ext:auth-type\":\"login\"}}"
+	t.Run("Test get on TACACS global settings", processGetRequest(url, nil, expected_get_json, false))
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=RADIUS]/config"
+	expected_get_json = "{\"openconfig-system:config\":{\"name\":\"RADIUS\",\"type\":\"openconfig-aaa:RADIUS\",\"openconfig-aaa-ext:secret-key\":\"********\",\"openconfig-aaa-ext:timeout\":9,\"openconfig-aaa-ext:auth-type\":\"chap\"}}"
+	t.Run("Test get on RADIUS global settings", processGetRequest(url, nil, expected_get_json, false))
+	t.Log("\n\n+++++++++++++ Done Performing Get on TACACS and RADIUS global settings ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Set on RADIUS global settings with TACACS auth type ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=RADIUS]/config"
+	url_body_json = "{\"openconfig-system:config\":{\"name\":\"RADIUS\",\"openconfig-aaa-ext:auth-type\":\"login\"}}"
+	expected_err = tlerr.InvalidArgsError{Format: "Authentication type login not allowed in server group RADIUS"}
+	t.Run("Test set on RADIUS global settings with TACACS auth type", processSetRequest(url, url_body_json, "PATCH", true, expected_err))
+	t.Log("\n\n+++++++++++++ Done Performing Set on RADIUS global settings with TACACS auth type ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Delete on TACACS and RADIUS global settings ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=TACACS]/config/openconfig-aaa-ext:secret-key"
+	t.Run("Test delete on TACACS global secret key", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+	expected_map = map[string]interface{}{"TACPLUS": map[string]interface{}{"global": map[string]interface{}{"timeout": "7", "auth_type": "login"}}}
+	t.Run("Verify delete on TACACS global secret key", verifyDbResult(rclient, "TACPLUS|global", expected_map, false))
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=RADIUS]/config"
+	t.Run("Test delete on RADIUS global settings", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify delete on RADIUS global settings", verifyDbResult(rclient, "RADIUS|global", map[string]interface{}{}, false))
+	t.Log("\n\n+++++++++++++ Done Performing Delete on TACACS and RADIUS global settings ++++++++++++")
+
+	t.Log("\n\n+++++++++++++ Performing Delete on TACACS server ++++++++++++")
+	url = "/openconfig-system:system/aaa/server-groups/server-group[name=TACACS]/servers/server[address=10.4.4.4]"
+	t.Run("Test delete on TACACS server", processDeleteRequest(url, false))
+	time.Sleep(1 * time.Second)
+	t.Run("Verify delete on TACACS server", verifyDbResult(rclient, "TACPLUS_SERVER|10.4.4.4", map[string]interface{}{}, false))
+	cleanuptbl = map[string]interface{}{"AAA": map[string]interface{}{"authentication": ""},
+		"TACPLUS_SERVER": map[string]interface{}{"10.4.4.4": ""},
+		"TACPLUS":        map[string]interface{}{"global": ""},
+		"RADIUS":         map[string]interface{}{"global": ""}}
+	unloadDB(db.ConfigDB, cleanuptbl)
+	t.Log("\n\n+++++++++++++ Done Performing Delete on TACACS server ++++++++++++")
+}
+
+func Test_openconfig_system_top_level(t *testing.T) {
+	var pre_req_map, state_pre_req_map map[string]interface{}
+
+	t.Log("\n\n+++++++++++++ Performing Get on system top level ++++++++++++")
+	pre_req_map = map[string]interface{}{"DEVICE_METADATA": map[string]interface{}{"localhost": map[string]interface{}{"hostname": "leaf1"}}}
+	loadDB(db.ConfigDB, pre_req_map)
+	state_pre_req_map = map[string]interface{}{"PROCESS_STATS": map[string]interface{}{"1234": map[string]interface{}{"CMD": "/usr/bin/sonic-mgmt", "%CPU": "1.5", "%MEM": "2.0"}}}
+	loadDB(db.StateDB, state_pre_req_map)
+	time.Sleep(1 * time.Second)
+	url := "/openconfig-system:system"
+	expected_get_json := "{\"openconfig-system:system\":{\"config\":{\"hostname\":\"leaf1\"},\"state\":{\"hostname\":\"leaf1\"},\"processes\":{\"process\":[{\"pid\":\"1234\",\"state\":{\"cpu-usage-system\":\"0\",\"cpu-usage-user\":\"0\",\"cpu-utilization\":1,\"memory-usage\":\"0\",\"memory-utilization\":2,\"name\":\"/usr/bin/sonic-mgmt\",\"pid\":\"1234\",\"start-time\":\"0\",\"uptime\":\"0\"}}]}}}"
+	t.Run("Test get on system top level", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	url = "/openconfig-system:system/processes/process[pid=1234]/state/name"
+	expected_get_json = "{\"openconfig-system:name\":\"/usr/bin/sonic-mgmt\"}"
+	t.Run("Test get on system process name", processGetRequest(url, nil, expected_get_json, false))
+	time.Sleep(1 * time.Second)
+
+	unloadDB(db.ConfigDB, pre_req_map)
+	unloadDB(db.StateDB, state_pre_req_map)
+	t.Log("\n\n+++++++++++++ Done Performing Get on system top level ++++++++++++")
+}
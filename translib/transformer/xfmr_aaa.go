@@ -0,0 +1,611 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package transformer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/ocbinds"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	log "github.com/golang/glog"
+	"github.com/openconfig/ygot/ygot"
+)
+
+const (
+	/* AAA tables */
+	AAA_TBL            = "AAA"
+	TACPLUS_TBL        = "TACPLUS"
+	RADIUS_TBL         = "RADIUS"
+	TACPLUS_SERVER_TBL = "TACPLUS_SERVER"
+	RADIUS_SERVER_TBL  = "RADIUS_SERVER"
+
+	/* Key of the TACPLUS and RADIUS global settings */
+	AAA_GLOBAL_KEY = "global"
+
+	/* AAA fields */
+	AAA_LOGIN_FIELD      = "login"
+	AAA_TIMEOUT_FIELD    = "timeout"
+	AAA_PASSKEY_FIELD    = "passkey"
+	TACPLUS_PORT_FIELD   = "tcp_port"
+	RADIUS_PORT_FIELD    = "auth_port"
+	RADIUS_RETRANS_FIELD = "retransmit"
+	AAA_AUTH_TYPE_FIELD  = "auth_type"
+
+	/* AAA server group names */
+	AAA_SERVER_GROUP_TACACS = "TACACS"
+	AAA_SERVER_GROUP_RADIUS = "RADIUS"
+
+	/* Secret keys are write-only; Get returns this mask when a key is set */
+	AAA_SECRET_KEY_MASK = "********"
+)
+
+/* Method names used by hostcfgd in the AAA table login field */
+var aaaMethodToDbMap = map[ocbinds.E_OpenconfigAaaTypes_AAA_METHOD_TYPE]string{
+	ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_TACACS_ALL: "tacacs+",
+	ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_RADIUS_ALL: "radius",
+	ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_LOCAL:      "local",
+}
+
+var aaaMethodFromDbMap = map[string]string{
+	"tacacs+": "openconfig-aaa-types:TACACS_ALL",
+	"radius":  "openconfig-aaa-types:RADIUS_ALL",
+	"local":   "openconfig-aaa-types:LOCAL",
+}
+
+var aaaAuthTypeFromDbMap = map[string]ocbinds.E_OpenconfigAaaExt_AaaAuthType{
+	"pap":      ocbinds.OpenconfigAaaExt_AaaAuthType_pap,
+	"chap":     ocbinds.OpenconfigAaaExt_AaaAuthType_chap,
+	"mschap":   ocbinds.OpenconfigAaaExt_AaaAuthType_mschap,
+	"mschapv2": ocbinds.OpenconfigAaaExt_AaaAuthType_mschapv2,
+	"login":    ocbinds.OpenconfigAaaExt_AaaAuthType_login,
+}
+
+/* Authentication types supported by hostcfgd, per global settings table */
+var aaaAuthTypeSupported = map[string]map[string]bool{
+	TACPLUS_TBL: {"pap": true, "chap": true, "mschap": true, "login": true},
+	RADIUS_TBL:  {"pap": true, "chap": true, "mschapv2": true},
+}
+
+func init() {
+	XlateFuncBind("YangToDb_aaa_authentication_method_xfmr", YangToDb_aaa_authentication_method_xfmr)
+	XlateFuncBind("DbToYang_aaa_authentication_method_xfmr", DbToYang_aaa_authentication_method_xfmr)
+	XlateFuncBind("YangToDb_aaa_authorization_method_xfmr", YangToDb_aaa_authorization_method_xfmr)
+	XlateFuncBind("DbToYang_aaa_authorization_method_xfmr", DbToYang_aaa_authorization_method_xfmr)
+	XlateFuncBind("YangToDb_aaa_server_groups_xfmr", YangToDb_aaa_server_groups_xfmr)
+	XlateFuncBind("DbToYang_aaa_server_groups_xfmr", DbToYang_aaa_server_groups_xfmr)
+	XlateFuncBind("Subscribe_aaa_server_groups_xfmr", Subscribe_aaa_server_groups_xfmr)
+}
+
+func getAaaRootObject(s *ygot.GoStruct) *ocbinds.OpenconfigSystem_System_Aaa {
+	deviceObj := (*s).(*ocbinds.Device)
+	if deviceObj.System == nil {
+		return nil
+	}
+	return deviceObj.System.Aaa
+}
+
+/* Returns the server table backing an AAA server group */
+func getAaaServerTblName(grpName string) (string, error) {
+	switch grpName {
+	case AAA_SERVER_GROUP_TACACS:
+		return TACPLUS_SERVER_TBL, nil
+	case AAA_SERVER_GROUP_RADIUS:
+		return RADIUS_SERVER_TBL, nil
+	}
+	return "", tlerr.InvalidArgsError{Format: "Server group " + grpName + " not supported, use " +
+		AAA_SERVER_GROUP_TACACS + " or " + AAA_SERVER_GROUP_RADIUS}
+}
+
+/* Returns the table of the global settings of the servers in a server table */
+func getAaaGlobalTblName(srvTblName string) string {
+	if srvTblName == TACPLUS_SERVER_TBL {
+		return TACPLUS_TBL
+	}
+	return RADIUS_TBL
+}
+
+func aaaMethodToDb(method string, idMethod ocbinds.E_OpenconfigAaaTypes_AAA_METHOD_TYPE) (string, error) {
+	if idMethod != ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_UNSET {
+		if dbMethod, ok := aaaMethodToDbMap[idMethod]; ok {
+			return dbMethod, nil
+		}
+		return "", tlerr.NotSupported("AAA method not supported")
+	}
+	switch method {
+	case AAA_SERVER_GROUP_TACACS:
+		return "tacacs+", nil
+	case AAA_SERVER_GROUP_RADIUS:
+		return "radius", nil
+	}
+	return "", tlerr.InvalidArgsError{Format: "AAA method " + method + " not supported"}
+}
+
+func aaaMethodListToDb(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[AAA_LOGIN_FIELD] = ""
+		return res_map, nil
+	}
+
+	var methods []string
+	appendMethod := func(method string, idMethod ocbinds.E_OpenconfigAaaTypes_AAA_METHOD_TYPE) error {
+		dbMethod, err := aaaMethodToDb(method, idMethod)
+		if err == nil {
+			methods = append(methods, dbMethod)
+		}
+		return err
+	}
+
+	var err error
+	switch methodList := inParams.param.(type) {
+	case []ocbinds.OpenconfigSystem_System_Aaa_Authentication_Config_AuthenticationMethod_Union:
+		for _, m := range methodList {
+			switch v := m.(type) {
+			case *ocbinds.OpenconfigSystem_System_Aaa_Authentication_Config_AuthenticationMethod_Union_E_OpenconfigAaaTypes_AAA_METHOD_TYPE:
+				err = appendMethod("", v.E_OpenconfigAaaTypes_AAA_METHOD_TYPE)
+			case *ocbinds.OpenconfigSystem_System_Aaa_Authentication_Config_AuthenticationMethod_Union_String:
+				err = appendMethod(v.String, ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_UNSET)
+			}
+			if err != nil {
+				return res_map, err
+			}
+		}
+	case []ocbinds.OpenconfigSystem_System_Aaa_Authorization_Config_AuthorizationMethod_Union:
+		for _, m := range methodList {
+			switch v := m.(type) {
+			case *ocbinds.OpenconfigSystem_System_Aaa_Authorization_Config_AuthorizationMethod_Union_E_OpenconfigAaaTypes_AAA_METHOD_TYPE:
+				err = appendMethod("", v.E_OpenconfigAaaTypes_AAA_METHOD_TYPE)
+			case *ocbinds.OpenconfigSystem_System_Aaa_Authorization_Config_AuthorizationMethod_Union_String:
+				err = appendMethod(v.String, ocbinds.OpenconfigAaaTypes_AAA_METHOD_TYPE_UNSET)
+			}
+			if err != nil {
+				return res_map, err
+			}
+		}
+	}
+
+	if len(methods) > 0 {
+		res_map[AAA_LOGIN_FIELD] = strings.Join(methods, ",")
+	}
+	return res_map, nil
+}
+
+func aaaMethodListFromDb(inParams XfmrParams, aaaKey string, leafName string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	entry, ok := data[AAA_TBL][aaaKey]
+	if !ok {
+		log.V(3).Info("AAA entry not found : ", aaaKey)
+		return result, nil
+	}
+	login, ok := entry.Field[AAA_LOGIN_FIELD]
+	if !ok || len(login) == 0 {
+		return result, nil
+	}
+	var methods []interface{}
+	for _, m := range strings.Split(login, ",") {
+		if ocMethod, ok := aaaMethodFromDbMap[m]; ok {
+			methods = append(methods, ocMethod)
+		} else {
+			methods = append(methods, m)
+		}
+	}
+	result[leafName] = methods
+	return result, nil
+}
+
+var YangToDb_aaa_authentication_method_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	return aaaMethodListToDb(inParams)
+}
+
+var DbToYang_aaa_authentication_method_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	return aaaMethodListFromDb(inParams, "authentication", "authentication-method")
+}
+
+var YangToDb_aaa_authorization_method_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	return aaaMethodListToDb(inParams)
+}
+
+var DbToYang_aaa_authorization_method_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	return aaaMethodListFromDb(inParams, "authorization", "authorization-method")
+}
+
+/* Maps a server leaf, or a protocol container, to the fields it owns */
+var aaaServerLeafFieldMap = map[string][]string{
+	"timeout":             {AAA_TIMEOUT_FIELD},
+	"port":                {TACPLUS_PORT_FIELD},
+	"auth-port":           {RADIUS_PORT_FIELD},
+	"secret-key":          {AAA_PASSKEY_FIELD},
+	"retransmit-attempts": {RADIUS_RETRANS_FIELD},
+	"tacacs":              {TACPLUS_PORT_FIELD, AAA_PASSKEY_FIELD},
+	"radius":              {RADIUS_PORT_FIELD, AAA_PASSKEY_FIELD, RADIUS_RETRANS_FIELD},
+}
+
+/* Maps a server group config leaf to the global settings field it owns */
+var aaaGroupLeafFieldMap = map[string]string{
+	"secret-key": AAA_PASSKEY_FIELD,
+	"timeout":    AAA_TIMEOUT_FIELD,
+	"auth-type":  AAA_AUTH_TYPE_FIELD,
+}
+
+/* Returns the global settings fields of the server group to be deleted; only
+ * the ones present, as the global entry has other owners too. */
+func getAaaGlobalDelEntry(d *db.DB, srvTblName string, leafName string) (db.Value, bool) {
+	delEntry := db.Value{Field: make(map[string]string)}
+	entry, err := d.GetEntry(&db.TableSpec{Name: getAaaGlobalTblName(srvTblName)}, db.Key{Comp: []string{AAA_GLOBAL_KEY}})
+	if err != nil {
+		return delEntry, false
+	}
+	for leaf, field := range aaaGroupLeafFieldMap {
+		if leafName != "" && leaf != leafName {
+			continue
+		}
+		if _, ok := entry.Field[field]; ok {
+			delEntry.Field[field] = ""
+		}
+	}
+	return delEntry, len(delEntry.Field) > 0
+}
+
+func getAaaServerDelMap(d *db.DB, grpName string, address string, targetUriPath string) (map[string]map[string]db.Value, error) {
+	res_map := make(map[string]map[string]db.Value)
+
+	grpNames := []string{AAA_SERVER_GROUP_TACACS, AAA_SERVER_GROUP_RADIUS}
+	if grpName != "" {
+		grpNames = []string{grpName}
+	}
+
+	if address == "" {
+		/* The server group config owns the global settings, the servers
+		 * container the servers; the server group both. */
+		delServers, delGlobal := true, true
+		grpLeaf := ""
+		if _, grpConfig, ok := strings.Cut(targetUriPath, "/server-group/config"); ok {
+			grpLeaf = strings.TrimPrefix(grpConfig, "/")
+			if _, ok := aaaGroupLeafFieldMap[grpLeaf]; grpLeaf != "" && !ok {
+				return res_map, nil
+			}
+			delServers = false
+		} else if strings.HasSuffix(targetUriPath, "/servers") {
+			delGlobal = false
+		}
+		for _, name := range grpNames {
+			tblName, err := getAaaServerTblName(name)
+			if err != nil {
+				return res_map, err
+			}
+			if delServers {
+				res_map[tblName] = make(map[string]db.Value)
+			}
+			if !delGlobal {
+				continue
+			}
+			if delEntry, ok := getAaaGlobalDelEntry(d, tblName, grpLeaf); ok {
+				res_map[getAaaGlobalTblName(tblName)] = map[string]db.Value{AAA_GLOBAL_KEY: delEntry}
+			}
+		}
+		return res_map, nil
+	}
+
+	tblName, err := getAaaServerTblName(grpName)
+	if err != nil {
+		return res_map, err
+	}
+	res_map[tblName] = make(map[string]db.Value)
+
+	srvEntry := db.Value{Field: make(map[string]string)}
+	elems := strings.Split(strings.TrimSuffix(targetUriPath, "/config"), "/")
+	if fields, ok := aaaServerLeafFieldMap[elems[len(elems)-1]]; ok {
+		for _, f := range fields {
+			srvEntry.Field[f] = ""
+		}
+	}
+	res_map[tblName][address] = srvEntry
+	return res_map, nil
+}
+
+var YangToDb_aaa_server_groups_xfmr SubTreeXfmrYangToDb = func(inParams XfmrParams) (map[string]map[string]db.Value, error) {
+	res_map := make(map[string]map[string]db.Value)
+
+	pathInfo := NewPathInfo(inParams.uri)
+	targetUriPath, _, _ := XfmrRemoveXPATHPredicates(inParams.uri)
+	log.V(3).Info("YangToDb_aaa_server_groups_xfmr: ", inParams.uri)
+
+	if inParams.oper == DELETE {
+		return getAaaServerDelMap(inParams.d, pathInfo.Var("name"), pathInfo.Var("address"), targetUriPath)
+	}
+
+	aaaObj := getAaaRootObject(inParams.ygRoot)
+	if aaaObj == nil || aaaObj.ServerGroups == nil {
+		return res_map, nil
+	}
+
+	for grpName, grp := range aaaObj.ServerGroups.ServerGroup {
+		tblName, err := getAaaServerTblName(grpName)
+		if err != nil {
+			return res_map, err
+		}
+		if grp.Config != nil && grp.Config.Type != ocbinds.OpenconfigAaaTypes_AAA_SERVER_TYPE_UNSET {
+			if (tblName == TACPLUS_SERVER_TBL && grp.Config.Type != ocbinds.OpenconfigAaaTypes_AAA_SERVER_TYPE_TACACS) ||
+				(tblName == RADIUS_SERVER_TBL && grp.Config.Type != ocbinds.OpenconfigAaaTypes_AAA_SERVER_TYPE_RADIUS) {
+				return res_map, tlerr.InvalidArgsError{Format: "Server group " + grpName + " type mismatch"}
+			}
+		}
+		if grp.Config != nil {
+			globalEntry := db.Value{Field: make(map[string]string)}
+			if grp.Config.SecretKey != nil {
+				globalEntry.Field[AAA_PASSKEY_FIELD] = *grp.Config.SecretKey
+			}
+			if grp.Config.Timeout != nil {
+				globalEntry.Field[AAA_TIMEOUT_FIELD] = strconv.FormatUint(uint64(*grp.Config.Timeout), 10)
+			}
+			if grp.Config.AuthType != ocbinds.OpenconfigAaaExt_AaaAuthType_UNSET {
+				authType, err := ygot.EnumName(grp.Config.AuthType)
+				if err != nil {
+					return res_map, err
+				}
+				if !aaaAuthTypeSupported[getAaaGlobalTblName(tblName)][authType] {
+					return res_map, tlerr.InvalidArgsError{Format: "Authentication type " + authType + " not allowed in server group " + grpName}
+				}
+				globalEntry.Field[AAA_AUTH_TYPE_FIELD] = authType
+			}
+			if len(globalEntry.Field) > 0 {
+				res_map[getAaaGlobalTblName(tblName)] = map[string]db.Value{AAA_GLOBAL_KEY: globalEntry}
+			}
+		}
+		if grp.Servers == nil {
+			continue
+		}
+
+		srvMap := make(map[string]db.Value)
+		for address, srv := range grp.Servers.Server {
+			srvEntry := db.Value{Field: make(map[string]string)}
+			if srv.Config != nil && srv.Config.Timeout != nil {
+				srvEntry.Field[AAA_TIMEOUT_FIELD] = strconv.FormatUint(uint64(*srv.Config.Timeout), 10)
+			}
+			if srv.Tacacs != nil && srv.Tacacs.Config != nil {
+				if tblName != TACPLUS_SERVER_TBL {
+					return res_map, tlerr.InvalidArgsError{Format: "TACACS configuration not allowed in server group " + grpName}
+				}
+				if srv.Tacacs.Config.Port != nil {
+					srvEntry.Field[TACPLUS_PORT_FIELD] = strconv.FormatUint(uint64(*srv.Tacacs.Config.Port), 10)
+				}
+				if srv.Tacacs.Config.SecretKey != nil {
+					srvEntry.Field[AAA_PASSKEY_FIELD] = *srv.Tacacs.Config.SecretKey
+				}
+			}
+			if srv.Radius != nil && srv.Radius.Config != nil {
+				if tblName != RADIUS_SERVER_TBL {
+					return res_map, tlerr.InvalidArgsError{Format: "RADIUS configuration not allowed in server group " + grpName}
+				}
+				if srv.Radius.Config.AuthPort != nil {
+					srvEntry.Field[RADIUS_PORT_FIELD] = strconv.FormatUint(uint64(*srv.Radius.Config.AuthPort), 10)
+				}
+				if srv.Radius.Config.SecretKey != nil {
+					srvEntry.Field[AAA_PASSKEY_FIELD] = *srv.Radius.Config.SecretKey
+				}
+				if srv.Radius.Config.RetransmitAttempts != nil {
+					srvEntry.Field[RADIUS_RETRANS_FIELD] = strconv.FormatUint(uint64(*srv.Radius.Config.RetransmitAttempts), 10)
+				}
+			}
+			if len(srvEntry.Field) == 0 {
+				srvEntry.Field["NULL"] = "NULL"
+			}
+			srvMap[address] = srvEntry
+		}
+		if len(srvMap) > 0 {
+			res_map[tblName] = srvMap
+		}
+	}
+
+	return res_map, nil
+}
+
+func fillAaaServerInfo(srv *ocbinds.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server,
+	tblName string, address string, entry db.Value) {
+	ygot.BuildEmptyTree(srv)
+	srv.Config.Address = &address
+	srv.State.Address = &address
+
+	if v, ok := entry.Field[AAA_TIMEOUT_FIELD]; ok {
+		if tmp, err := strconv.ParseUint(v, 10, 16); err == nil {
+			timeout := uint16(tmp)
+			srv.Config.Timeout = &timeout
+			srv.State.Timeout = &timeout
+		}
+	}
+
+	var secretKey *string
+	if v, ok := entry.Field[AAA_PASSKEY_FIELD]; ok && len(v) > 0 {
+		mask := AAA_SECRET_KEY_MASK
+		secretKey = &mask
+	}
+
+	if tblName == TACPLUS_SERVER_TBL {
+		srv.Radius = nil
+		ygot.BuildEmptyTree(srv.Tacacs)
+		if v, ok := entry.Field[TACPLUS_PORT_FIELD]; ok {
+			if tmp, err := strconv.ParseUint(v, 10, 16); err == nil {
+				port := uint16(tmp)
+				srv.Tacacs.Config.Port = &port
+				srv.Tacacs.State.Port = &port
+			}
+		}
+		srv.Tacacs.Config.SecretKey = secretKey
+		srv.Tacacs.State.SecretKey = secretKey
+		return
+	}
+
+	srv.Tacacs = nil
+	ygot.BuildEmptyTree(srv.Radius)
+	if v, ok := entry.Field[RADIUS_PORT_FIELD]; ok {
+		if tmp, err := strconv.ParseUint(v, 10, 16); err == nil {
+			port := uint16(tmp)
+			srv.Radius.Config.AuthPort = &port
+			srv.Radius.State.AuthPort = &port
+		}
+	}
+	if v, ok := entry.Field[RADIUS_RETRANS_FIELD]; ok {
+		if tmp, err := strconv.ParseUint(v, 10, 8); err == nil {
+			retrans := uint8(tmp)
+			srv.Radius.Config.RetransmitAttempts = &retrans
+			srv.Radius.State.RetransmitAttempts = &retrans
+		}
+	}
+	srv.Radius.Config.SecretKey = secretKey
+	srv.Radius.State.SecretKey = secretKey
+}
+
+/* Fills the server group config and state from the global settings entry */
+func fillAaaServerGroupInfo(grp *ocbinds.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup, entry db.Value) {
+	if v, ok := entry.Field[AAA_PASSKEY_FIELD]; ok && len(v) > 0 {
+		mask := AAA_SECRET_KEY_MASK
+		grp.Config.SecretKey = &mask
+		grp.State.SecretKey = &mask
+	}
+	if v, ok := entry.Field[AAA_TIMEOUT_FIELD]; ok {
+		if tmp, err := strconv.ParseUint(v, 10, 16); err == nil {
+			timeout := uint16(tmp)
+			grp.Config.Timeout = &timeout
+			grp.State.Timeout = &timeout
+		}
+	}
+	if v, ok := entry.Field[AAA_AUTH_TYPE_FIELD]; ok {
+		if authType, ok := aaaAuthTypeFromDbMap[v]; ok {
+			grp.Config.AuthType = authType
+			grp.State.AuthType = authType
+		}
+	}
+}
+
+var DbToYang_aaa_server_groups_xfmr SubTreeXfmrDbToYang = func(inParams XfmrParams) error {
+	pathInfo := NewPathInfo(inParams.uri)
+	grpName := pathInfo.Var("name")
+	address := pathInfo.Var("address")
+	log.V(3).Info("DbToYang_aaa_server_groups_xfmr: ", inParams.uri)
+
+	deviceObj := (*inParams.ygRoot).(*ocbinds.Device)
+	if deviceObj.System == nil {
+		ygot.BuildEmptyTree(deviceObj)
+	}
+	if deviceObj.System.Aaa == nil {
+		ygot.BuildEmptyTree(deviceObj.System)
+	}
+	aaaObj := deviceObj.System.Aaa
+	ygot.BuildEmptyTree(aaaObj)
+
+	grpNames := []string{AAA_SERVER_GROUP_TACACS, AAA_SERVER_GROUP_RADIUS}
+	if grpName != "" {
+		grpNames = []string{grpName}
+	}
+
+	d := inParams.dbs[db.ConfigDB]
+	for _, name := range grpNames {
+		tblName, err := getAaaServerTblName(name)
+		if err != nil {
+			return tlerr.NotFound("Resource not found")
+		}
+		keys, _ := d.GetKeys(&db.TableSpec{Name: tblName})
+		globalEntry, _ := d.GetEntry(&db.TableSpec{Name: getAaaGlobalTblName(tblName)}, db.Key{Comp: []string{AAA_GLOBAL_KEY}})
+		hasGlobal := false
+		for _, field := range aaaGroupLeafFieldMap {
+			if _, ok := globalEntry.Field[field]; ok {
+				hasGlobal = true
+			}
+		}
+		if grpName == "" && len(keys) == 0 && !hasGlobal {
+			continue
+		}
+
+		grp, ok := aaaObj.ServerGroups.ServerGroup[name]
+		if !ok {
+			grp, _ = aaaObj.ServerGroups.NewServerGroup(name)
+		}
+		ygot.BuildEmptyTree(grp)
+		grpNameCopy := name
+		grp.Config.Name = &grpNameCopy
+		grp.State.Name = &grpNameCopy
+		if tblName == TACPLUS_SERVER_TBL {
+			grp.Config.Type = ocbinds.OpenconfigAaaTypes_AAA_SERVER_TYPE_TACACS
+		} else {
+			grp.Config.Type = ocbinds.OpenconfigAaaTypes_AAA_SERVER_TYPE_RADIUS
+		}
+		grp.State.Type = grp.Config.Type
+		fillAaaServerGroupInfo(grp, globalEntry)
+
+		found := false
+		for _, key := range keys {
+			srvAddr := key.Get(0)
+			if address != "" && srvAddr != address {
+				continue
+			}
+			entry, err := d.GetEntry(&db.TableSpec{Name: tblName}, key)
+			if err != nil {
+				log.Warningf("DbToYang_aaa_server_groups_xfmr: failed to read %v|%v: %v", tblName, srvAddr, err)
+				continue
+			}
+			srv, ok := grp.Servers.Server[srvAddr]
+			if !ok {
+				srv, _ = grp.Servers.NewServer(srvAddr)
+			}
+			fillAaaServerInfo(srv, tblName, srvAddr, entry)
+			found = true
+		}
+		if address != "" && !found {
+			return tlerr.NotFound("Resource not found")
+		}
+	}
+
+	return nil
+}
+
+var Subscribe_aaa_server_groups_xfmr SubTreeXfmrSubscribe = func(inParams XfmrSubscInParams) (XfmrSubscOutParams, error) {
+	var result XfmrSubscOutParams
+	result.dbDataMap = make(RedisDbSubscribeMap)
+
+	pathInfo := NewPathInfo(inParams.uri)
+	grpName := pathInfo.Var("name")
+	address := pathInfo.Var("address")
+	if address == "" {
+		address = "*"
+	}
+
+	tblNames := []string{TACPLUS_SERVER_TBL, RADIUS_SERVER_TBL}
+	if grpName != "" {
+		tblName, err := getAaaServerTblName(grpName)
+		if err != nil {
+			return result, err
+		}
+		tblNames = []string{tblName}
+	}
+
+	result.dbDataMap[db.ConfigDB] = make(map[string]map[string]map[string]string)
+	for _, tblName := range tblNames {
+		result.dbDataMap[db.ConfigDB][tblName] = map[string]map[string]string{address: {}}
+		if address == "*" {
+			result.dbDataMap[db.ConfigDB][getAaaGlobalTblName(tblName)] = map[string]map[string]string{AAA_GLOBAL_KEY: {}}
+		}
+	}
+	log.V(3).Infof("Subscribe_aaa_server_groups_xfmr: %v", result.dbDataMap)
+
+	return result, nil
+}
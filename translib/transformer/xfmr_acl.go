@@ -0,0 +1,1065 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2019 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package transformer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/ocbinds"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	log "github.com/golang/glog"
+	"github.com/openconfig/ygot/ygot"
+)
+
+const (
+	/* ACL tables */
+	ACL_TABLE      = "ACL_TABLE"
+	ACL_RULE_TABLE = "ACL_RULE"
+
+	/* ACL_TABLE fields */
+	ACL_TYPE_FIELD        = "type"
+	ACL_DESCRIPTION_FIELD = "policy_desc"
+	ACL_STAGE_FIELD       = "stage"
+	ACL_PORTS_FIELD       = "ports"
+
+	/* ACL_RULE fields */
+	ACL_PRIORITY_FIELD      = "PRIORITY"
+	ACL_PACKET_ACTION_FIELD = "PACKET_ACTION"
+	ACL_IP_TYPE_FIELD       = "IP_TYPE"
+	ACL_IP_PROTOCOL_FIELD   = "IP_PROTOCOL"
+	ACL_ETHER_TYPE_FIELD    = "ETHER_TYPE"
+	ACL_L4_SRC_PORT         = "L4_SRC_PORT"
+	ACL_L4_SRC_PORT_RANGE   = "L4_SRC_PORT_RANGE"
+	ACL_L4_DST_PORT         = "L4_DST_PORT"
+	ACL_L4_DST_PORT_RANGE   = "L4_DST_PORT_RANGE"
+	ACL_TCP_FLAGS_FIELD     = "TCP_FLAGS"
+
+	/* Openconfig ACL types, also used as the ACL_TABLE key suffix */
+	OC_ACL_TYPE_IPV4 = "ACL_IPV4"
+	OC_ACL_TYPE_IPV6 = "ACL_IPV6"
+	OC_ACL_TYPE_L2   = "ACL_L2"
+
+	ACL_STAGE_INGRESS = "INGRESS"
+	ACL_STAGE_EGRESS  = "EGRESS"
+
+	ACL_RULE_PREFIX  = "RULE_"
+	ACL_DEFAULT_RULE = "DEFAULT_RULE"
+	ACL_MIN_PRIORITY = 1
+	ACL_MAX_PRIORITY = 65536
+
+	OC_ACL_MODULE_PREFIX = "openconfig-acl:"
+	OC_ACL_XPATH         = "/openconfig-acl:acl"
+	OC_ACL_SETS_XPATH    = "/openconfig-acl:acl/acl-sets"
+	OC_ACL_SET_XPATH     = "/openconfig-acl:acl/acl-sets/acl-set"
+	OC_ACL_ENTRIES_XPATH = "/openconfig-acl:acl/acl-sets/acl-set/acl-entries"
+)
+
+/* Openconfig ACL type to SONiC ACL_TABLE type */
+var aclTypeOCToSonicMap = map[string]string{
+	OC_ACL_TYPE_IPV4: "L3",
+	OC_ACL_TYPE_IPV6: "L3V6",
+	OC_ACL_TYPE_L2:   "L2",
+}
+
+/* IP_TYPE of the rules, by openconfig ACL type */
+var aclIpTypeMap = map[string]string{
+	OC_ACL_TYPE_IPV4: "IPV4ANY",
+	OC_ACL_TYPE_IPV6: "IPV6ANY",
+}
+
+var IP_PROTOCOL_MAP = map[ocbinds.E_OpenconfigPacketMatchTypes_IP_PROTOCOL]uint8{
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_ICMP: 1,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_IGMP: 2,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_TCP:  6,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_UDP:  17,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_RSVP: 46,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_GRE:  47,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_AUTH: 51,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_PIM:  103,
+	ocbinds.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_L2TP: 115,
+}
+
+var ETHERTYPE_MAP = map[ocbinds.E_OpenconfigPacketMatchTypes_ETHERTYPE]uint32{
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_LLDP: 0x88CC,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_VLAN: 0x8100,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_ROCE: 0x8915,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_ARP:  0x0806,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_IPV4: 0x0800,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_IPV6: 0x86DD,
+	ocbinds.OpenconfigPacketMatchTypes_ETHERTYPE_ETHERTYPE_MPLS: 0x8847,
+}
+
+/* TCP_FLAGS bit of each openconfig TCP flag */
+var TCP_FLAGS_MAP = map[ocbinds.E_OpenconfigPacketMatchTypes_TCP_FLAGS]uint32{
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_FIN: 0x01,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_SYN: 0x02,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_RST: 0x04,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_PSH: 0x08,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_ACK: 0x10,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_URG: 0x20,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_ECE: 0x40,
+	ocbinds.OpenconfigPacketMatchTypes_TCP_FLAGS_TCP_CWR: 0x80,
+}
+
+func init() {
+	XlateFuncBind("acl_post_xfmr", acl_post_xfmr)
+	XlateFuncBind("YangToDb_acl_set_key_xfmr", YangToDb_acl_set_key_xfmr)
+	XlateFuncBind("DbToYang_acl_set_key_xfmr", DbToYang_acl_set_key_xfmr)
+	XlateFuncBind("YangToDb_acl_set_name_xfmr", YangToDb_acl_set_name_xfmr)
+	XlateFuncBind("DbToYang_acl_set_name_xfmr", DbToYang_acl_set_name_xfmr)
+	XlateFuncBind("YangToDb_acl_type_field_xfmr", YangToDb_acl_type_field_xfmr)
+	XlateFuncBind("DbToYang_acl_type_field_xfmr", DbToYang_acl_type_field_xfmr)
+	XlateFuncBind("YangToDb_acl_entry_key_xfmr", YangToDb_acl_entry_key_xfmr)
+	XlateFuncBind("DbToYang_acl_entry_key_xfmr", DbToYang_acl_entry_key_xfmr)
+	XlateFuncBind("YangToDb_acl_entry_sequenceid_xfmr", YangToDb_acl_entry_sequenceid_xfmr)
+	XlateFuncBind("DbToYang_acl_entry_sequenceid_xfmr", DbToYang_acl_entry_sequenceid_xfmr)
+	XlateFuncBind("YangToDb_acl_entry_description_xfmr", YangToDb_acl_entry_description_xfmr)
+	XlateFuncBind("DbToYang_acl_entry_description_xfmr", DbToYang_acl_entry_description_xfmr)
+	XlateFuncBind("DbToYang_acl_entry_matched_packets_xfmr", DbToYang_acl_entry_matched_packets_xfmr)
+	XlateFuncBind("DbToYang_acl_entry_matched_octets_xfmr", DbToYang_acl_entry_matched_octets_xfmr)
+	XlateFuncBind("validate_ipv4", validate_ipv4)
+	XlateFuncBind("validate_ipv6", validate_ipv6)
+	XlateFuncBind("YangToDb_acl_ip_protocol_xfmr", YangToDb_acl_ip_protocol_xfmr)
+	XlateFuncBind("DbToYang_acl_ip_protocol_xfmr", DbToYang_acl_ip_protocol_xfmr)
+	XlateFuncBind("YangToDb_acl_source_port_xfmr", YangToDb_acl_source_port_xfmr)
+	XlateFuncBind("DbToYang_acl_source_port_xfmr", DbToYang_acl_source_port_xfmr)
+	XlateFuncBind("YangToDb_acl_destination_port_xfmr", YangToDb_acl_destination_port_xfmr)
+	XlateFuncBind("DbToYang_acl_destination_port_xfmr", DbToYang_acl_destination_port_xfmr)
+	XlateFuncBind("YangToDb_acl_tcp_flags_xfmr", YangToDb_acl_tcp_flags_xfmr)
+	XlateFuncBind("DbToYang_acl_tcp_flags_xfmr", DbToYang_acl_tcp_flags_xfmr)
+	XlateFuncBind("YangToDb_acl_l2_ethertype_xfmr", YangToDb_acl_l2_ethertype_xfmr)
+	XlateFuncBind("DbToYang_acl_l2_ethertype_xfmr", DbToYang_acl_l2_ethertype_xfmr)
+	XlateFuncBind("YangToDb_acl_forwarding_action_xfmr", YangToDb_acl_forwarding_action_xfmr)
+	XlateFuncBind("DbToYang_acl_forwarding_action_xfmr", DbToYang_acl_forwarding_action_xfmr)
+	XlateFuncBind("YangToDb_acl_port_bindings_xfmr", YangToDb_acl_port_bindings_xfmr)
+	XlateFuncBind("DbToYang_acl_port_bindings_xfmr", DbToYang_acl_port_bindings_xfmr)
+	XlateFuncBind("Subscribe_acl_port_bindings_xfmr", Subscribe_acl_port_bindings_xfmr)
+}
+
+func getAclRoot(s *ygot.GoStruct) *ocbinds.OpenconfigAcl_Acl {
+	deviceObj := (*s).(*ocbinds.Device)
+	return deviceObj.Acl
+}
+
+/* ACL_TABLE key of an openconfig ACL, e.g. "MyACL1_ACL_IPV4" */
+func getAclKeyStrFromOCKey(aclName string, aclType string) string {
+	aclN := strings.Replace(strings.Replace(aclName, " ", "_", -1), "-", "_", -1)
+	return aclN + "_" + strings.TrimPrefix(aclType, OC_ACL_MODULE_PREFIX)
+}
+
+/* Openconfig ACL name and type of an ACL_TABLE key */
+func getOCAclKeysFromStrKey(aclKey string) (string, string) {
+	for _, aclType := range []string{OC_ACL_TYPE_IPV4, OC_ACL_TYPE_IPV6, OC_ACL_TYPE_L2} {
+		if strings.HasSuffix(aclKey, "_"+aclType) {
+			return strings.TrimSuffix(aclKey, "_"+aclType), aclType
+		}
+	}
+	return aclKey, ""
+}
+
+/* ACL_TABLE key and rule name of an ACL_RULE key */
+func splitAclRuleKey(ruleKey string) (string, string) {
+	if idx := strings.LastIndex(ruleKey, "|"); idx >= 0 {
+		return ruleKey[:idx], ruleKey[idx+1:]
+	}
+	return ruleKey, ""
+}
+
+/* ACL_TABLE key from the acl-set keys of a URI, empty if the URI has none */
+func getAclKeyFromUri(uri string) string {
+	pathInfo := NewPathInfo(uri)
+	aclName := pathInfo.Var("name")
+	aclType := pathInfo.Var("type")
+	if len(aclName) == 0 || len(aclType) == 0 {
+		return ""
+	}
+	return getAclKeyStrFromOCKey(aclName, aclType)
+}
+
+func getAclRuleEntry(inParams XfmrParams) (db.Value, bool) {
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	entry, ok := data[ACL_RULE_TABLE][inParams.key]
+	if !ok {
+		log.V(3).Info("ACL rule not found : ", inParams.key)
+	}
+	return entry, ok
+}
+
+/* Fields of the ACL rule to be removed on a leaf delete; only the ones present are returned */
+func aclRuleFieldsToDelete(inParams XfmrParams, fields ...string) map[string]string {
+	res_map := make(map[string]string)
+	entry, err := inParams.d.GetEntry(&db.TableSpec{Name: ACL_RULE_TABLE}, db.Key{Comp: []string{inParams.key}})
+	for _, field := range fields {
+		if err != nil || entry.Has(field) {
+			res_map[field] = ""
+		}
+	}
+	return res_map
+}
+
+var acl_post_xfmr PostXfmrFunc = func(inParams XfmrParams) (map[string]map[string]db.Value, error) {
+	retDbDataMap := (*inParams.dbDataMap)[inParams.curDb]
+	if retDbDataMap == nil {
+		retDbDataMap = make(map[string]map[string]db.Value)
+	}
+	xpath, _, _ := XfmrRemoveXPATHPredicates(inParams.requestUri)
+	log.V(3).Info("acl_post_xfmr: ", inParams.requestUri)
+
+	if inParams.oper == DELETE {
+		return aclPostDelete(inParams, xpath, retDbDataMap)
+	}
+
+	/* Requests below the acl-set only modify the ACL or rule they address */
+	if strings.HasPrefix(xpath, OC_ACL_SET_XPATH+"/") && !strings.HasPrefix(xpath, OC_ACL_ENTRIES_XPATH) {
+		return retDbDataMap, nil
+	}
+
+	/* Rule priority and IP type are derived from the rule key; any request
+	   writing a rule, at any level, may be creating it */
+	for ruleKey, ruleEntry := range retDbDataMap[ACL_RULE_TABLE] {
+		aclKey, ruleName := splitAclRuleKey(ruleKey)
+		seqId, err := strconv.ParseUint(strings.TrimPrefix(ruleName, ACL_RULE_PREFIX), 10, 32)
+		if !strings.HasPrefix(ruleName, ACL_RULE_PREFIX) || err != nil {
+			continue
+		}
+		if ruleEntry.Field == nil {
+			ruleEntry.Field = make(map[string]string)
+		}
+		ruleEntry.Field[ACL_PRIORITY_FIELD] = strconv.FormatUint(ACL_MAX_PRIORITY-seqId, 10)
+		_, aclType := getOCAclKeysFromStrKey(aclKey)
+		if ipType, ok := aclIpTypeMap[aclType]; ok {
+			ruleEntry.Field[ACL_IP_TYPE_FIELD] = ipType
+		}
+		retDbDataMap[ACL_RULE_TABLE][ruleKey] = ruleEntry
+	}
+
+	if strings.HasPrefix(xpath, OC_ACL_ENTRIES_XPATH) || strings.HasPrefix(xpath, OC_ACL_XPATH+"/interfaces") {
+		return retDbDataMap, nil
+	}
+
+	/* Every ACL created or replaced through its acl-set gets a default deny rule */
+	aclObj := getAclRoot(inParams.ygRoot)
+	if aclObj == nil || aclObj.AclSets == nil {
+		return retDbDataMap, nil
+	}
+	for aclSetKey := range aclObj.AclSets.AclSet {
+		aclType, err := ygot.EnumName(aclSetKey.Type)
+		if err != nil {
+			return retDbDataMap, err
+		}
+		aclKey := getAclKeyStrFromOCKey(aclSetKey.Name, aclType)
+
+		if aclEntry, ok := retDbDataMap[ACL_TABLE][aclKey]; ok && !aclEntry.Has(ACL_TYPE_FIELD) {
+			if aclEntry.Field == nil {
+				aclEntry.Field = make(map[string]string)
+			}
+			aclEntry.Field[ACL_TYPE_FIELD] = aclTypeOCToSonicMap[aclType]
+			retDbDataMap[ACL_TABLE][aclKey] = aclEntry
+		}
+
+		if inParams.oper != REPLACE {
+			_, err = inParams.d.GetEntry(&db.TableSpec{Name: ACL_RULE_TABLE}, db.Key{Comp: []string{aclKey, ACL_DEFAULT_RULE}})
+			if err == nil {
+				continue
+			}
+		}
+		if _, ok := retDbDataMap[ACL_RULE_TABLE]; !ok {
+			retDbDataMap[ACL_RULE_TABLE] = make(map[string]db.Value)
+		}
+		retDbDataMap[ACL_RULE_TABLE][aclKey+"|"+ACL_DEFAULT_RULE] = db.Value{Field: map[string]string{
+			ACL_PRIORITY_FIELD:      strconv.Itoa(ACL_MIN_PRIORITY),
+			ACL_PACKET_ACTION_FIELD: "DROP",
+			ACL_IP_TYPE_FIELD:       "ANY",
+		}}
+	}
+
+	return retDbDataMap, nil
+}
+
+func aclPostDelete(inParams XfmrParams, xpath string, retDbDataMap map[string]map[string]db.Value) (map[string]map[string]db.Value, error) {
+	ruleTs := &db.TableSpec{Name: ACL_RULE_TABLE}
+
+	switch xpath {
+	case OC_ACL_XPATH, OC_ACL_SETS_XPATH:
+		/* Both tables are removed entirely, bindings included */
+		retDbDataMap[ACL_TABLE] = make(map[string]db.Value)
+		retDbDataMap[ACL_RULE_TABLE] = make(map[string]db.Value)
+	case OC_ACL_SET_XPATH:
+		if len(getAclKeyFromUri(inParams.requestUri)) == 0 {
+			retDbDataMap[ACL_TABLE] = make(map[string]db.Value)
+			retDbDataMap[ACL_RULE_TABLE] = make(map[string]db.Value)
+			break
+		}
+		/* Rules, default rule included, are removed along with the ACL_TABLE entry */
+		delete(retDbDataMap, ACL_RULE_TABLE)
+	case OC_ACL_ENTRIES_XPATH:
+		/* Remove the configured rules, but not the default rule */
+		aclKey := getAclKeyFromUri(inParams.requestUri)
+		ruleKeys, err := inParams.d.GetKeysPattern(ruleTs, db.Key{Comp: []string{aclKey, ACL_RULE_PREFIX + "*"}})
+		if err != nil {
+			return retDbDataMap, err
+		}
+		if len(ruleKeys) == 0 {
+			delete(retDbDataMap, ACL_RULE_TABLE)
+			break
+		}
+		retDbDataMap[ACL_RULE_TABLE] = make(map[string]db.Value)
+		for _, ruleKey := range ruleKeys {
+			retDbDataMap[ACL_RULE_TABLE][ruleKey.Get(0)+"|"+ruleKey.Get(1)] = db.Value{Field: map[string]string{}}
+		}
+	}
+	return retDbDataMap, nil
+}
+
+var YangToDb_acl_set_key_xfmr KeyXfmrYangToDb = func(inParams XfmrParams) (string, error) {
+	aclKey := getAclKeyFromUri(inParams.uri)
+	log.V(3).Info("YangToDb_acl_set_key_xfmr: ", inParams.uri, " key: ", aclKey)
+	return aclKey, nil
+}
+
+var DbToYang_acl_set_key_xfmr KeyXfmrDbToYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	rmap := make(map[string]interface{})
+	aclName, aclType := getOCAclKeysFromStrKey(inParams.key)
+	if len(aclType) == 0 {
+		log.V(3).Info("DbToYang_acl_set_key_xfmr: unknown ACL type for ", inParams.key)
+		return rmap, nil
+	}
+	rmap["name"] = aclName
+	rmap["type"] = aclType
+	return rmap, nil
+}
+
+var YangToDb_acl_set_name_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	/* ACL name is part of the ACL_TABLE key */
+	return make(map[string]string), nil
+}
+
+var DbToYang_acl_set_name_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	aclName, aclType := getOCAclKeysFromStrKey(inParams.key)
+	if len(aclType) > 0 {
+		result["name"] = aclName
+	}
+	return result, nil
+}
+
+var YangToDb_acl_type_field_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[ACL_TYPE_FIELD] = ""
+		return res_map, nil
+	}
+	aclType, ok := inParams.param.(ocbinds.E_OpenconfigAcl_ACL_TYPE)
+	if !ok {
+		return res_map, nil
+	}
+	aclTypeName, err := ygot.EnumName(aclType)
+	if err != nil {
+		return res_map, err
+	}
+	sonicType, ok := aclTypeOCToSonicMap[aclTypeName]
+	if !ok {
+		return res_map, tlerr.NotSupported("ACL Type '%s' not supported", aclTypeName)
+	}
+	res_map[ACL_TYPE_FIELD] = sonicType
+	return res_map, nil
+}
+
+var DbToYang_acl_type_field_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	_, aclType := getOCAclKeysFromStrKey(inParams.key)
+	if len(aclType) > 0 {
+		result["type"] = aclType
+	}
+	return result, nil
+}
+
+var YangToDb_acl_entry_key_xfmr KeyXfmrYangToDb = func(inParams XfmrParams) (string, error) {
+	pathInfo := NewPathInfo(inParams.uri)
+	aclKey := getAclKeyFromUri(inParams.uri)
+	seqId := pathInfo.Var("sequence-id")
+	if len(aclKey) == 0 || len(seqId) == 0 {
+		return "", nil
+	}
+	return aclKey + "|" + ACL_RULE_PREFIX + seqId, nil
+}
+
+var DbToYang_acl_entry_key_xfmr KeyXfmrDbToYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	rmap := make(map[string]interface{})
+	aclKey, ruleName := splitAclRuleKey(inParams.key)
+	/* The default rule is internal to SONiC and not reported */
+	if !strings.HasPrefix(ruleName, ACL_RULE_PREFIX) {
+		return rmap, nil
+	}
+	/* Rules of other ACLs are not part of this acl-set */
+	if uriAclKey := getAclKeyFromUri(inParams.uri); len(uriAclKey) > 0 && uriAclKey != aclKey {
+		return rmap, nil
+	}
+	seqId, err := strconv.ParseUint(strings.TrimPrefix(ruleName, ACL_RULE_PREFIX), 10, 32)
+	if err != nil {
+		log.V(3).Info("DbToYang_acl_entry_key_xfmr: invalid rule ", inParams.key)
+		return rmap, nil
+	}
+	rmap["sequence-id"] = uint32(seqId)
+	return rmap, nil
+}
+
+var YangToDb_acl_entry_sequenceid_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	/* Sequence id is part of the ACL_RULE key; its PRIORITY is set by acl_post_xfmr */
+	return make(map[string]string), nil
+}
+
+var DbToYang_acl_entry_sequenceid_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	rmap, err := DbToYang_acl_entry_key_xfmr(inParams)
+	if err != nil {
+		return result, err
+	}
+	if seqId, ok := rmap["sequence-id"]; ok {
+		result["sequence-id"] = seqId
+	}
+	return result, nil
+}
+
+var YangToDb_acl_entry_description_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	/* Rule description is not supported in SONiC */
+	return make(map[string]string), nil
+}
+
+var DbToYang_acl_entry_description_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	return make(map[string]interface{}), nil
+}
+
+/* Matched packets and octets of an ACL rule; 0 when counters are not available */
+func getAclEntryCounters(inParams XfmrParams, aclKey string, ruleName string) (uint64, uint64) {
+	countersDb := inParams.dbs[db.CountersDB]
+	if countersDb == nil {
+		return 0, 0
+	}
+	packets, octets, err := GetAclRuleCounters(countersDb, aclKey, ruleName)
+	if err != nil {
+		log.V(3).Infof("Counters not available for %s|%s; err=%v", aclKey, ruleName, err)
+		return 0, 0
+	}
+	return packets, octets
+}
+
+var DbToYang_acl_entry_matched_packets_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	aclKey, ruleName := splitAclRuleKey(inParams.key)
+	if len(ruleName) > 0 {
+		result["matched-packets"], _ = getAclEntryCounters(inParams, aclKey, ruleName)
+	}
+	return result, nil
+}
+
+var DbToYang_acl_entry_matched_octets_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	aclKey, ruleName := splitAclRuleKey(inParams.key)
+	if len(ruleName) > 0 {
+		_, result["matched-octets"] = getAclEntryCounters(inParams, aclKey, ruleName)
+	}
+	return result, nil
+}
+
+/* ipv4 and ipv6 containers are reported only for ACLs of that type */
+func validateAclType(inParams XfmrParams, aclType string) bool {
+	uriType := strings.TrimPrefix(NewPathInfo(inParams.uri).Var("type"), OC_ACL_MODULE_PREFIX)
+	if len(uriType) == 0 || uriType == "*" {
+		aclKey, _ := splitAclRuleKey(inParams.key)
+		if len(aclKey) == 0 {
+			return true
+		}
+		_, uriType = getOCAclKeysFromStrKey(aclKey)
+	}
+	return uriType == aclType
+}
+
+var validate_ipv4 ValidateCallpoint = func(inParams XfmrParams) bool {
+	return validateAclType(inParams, OC_ACL_TYPE_IPV4)
+}
+
+var validate_ipv6 ValidateCallpoint = func(inParams XfmrParams) bool {
+	return validateAclType(inParams, OC_ACL_TYPE_IPV6)
+}
+
+var YangToDb_acl_ip_protocol_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		return aclRuleFieldsToDelete(inParams, ACL_IP_PROTOCOL_FIELD), nil
+	}
+	switch v := inParams.param.(type) {
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4_Config_Protocol_Union_E_OpenconfigPacketMatchTypes_IP_PROTOCOL:
+		res_map[ACL_IP_PROTOCOL_FIELD] = strconv.FormatInt(int64(IP_PROTOCOL_MAP[v.E_OpenconfigPacketMatchTypes_IP_PROTOCOL]), 10)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4_Config_Protocol_Union_Uint8:
+		res_map[ACL_IP_PROTOCOL_FIELD] = strconv.FormatInt(int64(v.Uint8), 10)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv6_Config_Protocol_Union_E_OpenconfigPacketMatchTypes_IP_PROTOCOL:
+		res_map[ACL_IP_PROTOCOL_FIELD] = strconv.FormatInt(int64(IP_PROTOCOL_MAP[v.E_OpenconfigPacketMatchTypes_IP_PROTOCOL]), 10)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv6_Config_Protocol_Union_Uint8:
+		res_map[ACL_IP_PROTOCOL_FIELD] = strconv.FormatInt(int64(v.Uint8), 10)
+	}
+	return res_map, nil
+}
+
+var DbToYang_acl_ip_protocol_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok || !entry.Has(ACL_IP_PROTOCOL_FIELD) {
+		return result, nil
+	}
+	ipProto, err := strconv.ParseUint(entry.Get(ACL_IP_PROTOCOL_FIELD), 10, 8)
+	if err != nil {
+		return result, err
+	}
+	result["protocol"] = uint8(ipProto)
+	for k, v := range IP_PROTOCOL_MAP {
+		if uint8(ipProto) == v {
+			result["protocol"], _ = ygot.EnumName(k)
+			break
+		}
+	}
+	return result, nil
+}
+
+func aclTransportPortToDb(portVal interface{}, portField string, rangeField string) map[string]string {
+	res_map := make(map[string]string)
+	switch v := portVal.(type) {
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_SourcePort_Union_E_OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_SourcePort:
+		res_map[portField], _ = ygot.EnumName(v.E_OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_SourcePort)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_SourcePort_Union_String:
+		res_map[rangeField] = strings.Replace(v.String, "..", "-", 1)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_SourcePort_Union_Uint16:
+		res_map[portField] = strconv.FormatInt(int64(v.Uint16), 10)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_DestinationPort_Union_E_OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_DestinationPort:
+		res_map[portField], _ = ygot.EnumName(v.E_OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_DestinationPort)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_DestinationPort_Union_String:
+		res_map[rangeField] = strings.Replace(v.String, "..", "-", 1)
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config_DestinationPort_Union_Uint16:
+		res_map[portField] = strconv.FormatInt(int64(v.Uint16), 10)
+	}
+	return res_map
+}
+
+/* Port number, port range or ANY from the L4 port fields of a rule */
+func aclTransportPortFromDb(entry db.Value, portField string, rangeField string) (interface{}, bool) {
+	portVal := entry.Get(portField)
+	if entry.Has(rangeField) {
+		portVal = entry.Get(rangeField)
+	} else if !entry.Has(portField) {
+		return nil, false
+	}
+	if portNum, err := strconv.ParseUint(portVal, 10, 16); err == nil && portNum > 0 {
+		return uint16(portNum), true
+	}
+	if strings.Contains(portVal, "-") {
+		return portVal, true
+	}
+	return "ANY", true
+}
+
+var YangToDb_acl_source_port_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	if inParams.param == nil {
+		return aclRuleFieldsToDelete(inParams, ACL_L4_SRC_PORT, ACL_L4_SRC_PORT_RANGE), nil
+	}
+	return aclTransportPortToDb(inParams.param, ACL_L4_SRC_PORT, ACL_L4_SRC_PORT_RANGE), nil
+}
+
+var DbToYang_acl_source_port_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok {
+		return result, nil
+	}
+	if port, ok := aclTransportPortFromDb(entry, ACL_L4_SRC_PORT, ACL_L4_SRC_PORT_RANGE); ok {
+		result["source-port"] = port
+	}
+	return result, nil
+}
+
+var YangToDb_acl_destination_port_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	if inParams.param == nil {
+		return aclRuleFieldsToDelete(inParams, ACL_L4_DST_PORT, ACL_L4_DST_PORT_RANGE), nil
+	}
+	return aclTransportPortToDb(inParams.param, ACL_L4_DST_PORT, ACL_L4_DST_PORT_RANGE), nil
+}
+
+var DbToYang_acl_destination_port_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok {
+		return result, nil
+	}
+	if port, ok := aclTransportPortFromDb(entry, ACL_L4_DST_PORT, ACL_L4_DST_PORT_RANGE); ok {
+		result["destination-port"] = port
+	}
+	return result, nil
+}
+
+var YangToDb_acl_tcp_flags_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		return aclRuleFieldsToDelete(inParams, ACL_TCP_FLAGS_FIELD), nil
+	}
+	flags, _ := inParams.param.([]ocbinds.E_OpenconfigPacketMatchTypes_TCP_FLAGS)
+	if len(flags) == 0 {
+		return res_map, nil
+	}
+	var tcpFlags uint32
+	for _, flag := range flags {
+		tcpFlags |= TCP_FLAGS_MAP[flag]
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "0x%0.2x/0x%0.2x", tcpFlags, tcpFlags)
+	res_map[ACL_TCP_FLAGS_FIELD] = b.String()
+	return res_map, nil
+}
+
+var DbToYang_acl_tcp_flags_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok || len(entry.Get(ACL_TCP_FLAGS_FIELD)) == 0 {
+		return result, nil
+	}
+	flagStr := strings.Split(entry.Get(ACL_TCP_FLAGS_FIELD), "/")[0]
+	tcpFlags, err := strconv.ParseUint(strings.TrimPrefix(flagStr, "0x"), 16, 32)
+	if err != nil {
+		return result, err
+	}
+	var flags []interface{}
+	for bit := uint32(0x01); bit <= 0x80; bit <<= 1 {
+		if uint32(tcpFlags)&bit == 0 {
+			continue
+		}
+		for k, v := range TCP_FLAGS_MAP {
+			if v == bit {
+				flagName, _ := ygot.EnumName(k)
+				flags = append(flags, flagName)
+			}
+		}
+	}
+	result["tcp-flags"] = flags
+	return result, nil
+}
+
+var YangToDb_acl_l2_ethertype_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		return aclRuleFieldsToDelete(inParams, ACL_ETHER_TYPE_FIELD), nil
+	}
+	var b bytes.Buffer
+	switch v := inParams.param.(type) {
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_L2_Config_Ethertype_Union_E_OpenconfigPacketMatchTypes_ETHERTYPE:
+		fmt.Fprintf(&b, "0x%0.4x", ETHERTYPE_MAP[v.E_OpenconfigPacketMatchTypes_ETHERTYPE])
+		res_map[ACL_ETHER_TYPE_FIELD] = b.String()
+	case *ocbinds.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_L2_Config_Ethertype_Union_Uint16:
+		fmt.Fprintf(&b, "0x%0.4x", v.Uint16)
+		res_map[ACL_ETHER_TYPE_FIELD] = b.String()
+	}
+	return res_map, nil
+}
+
+var DbToYang_acl_l2_ethertype_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok || !entry.Has(ACL_ETHER_TYPE_FIELD) {
+		return result, nil
+	}
+	ethType, err := strconv.ParseUint(strings.TrimPrefix(entry.Get(ACL_ETHER_TYPE_FIELD), "0x"), 16, 32)
+	if err != nil {
+		return result, err
+	}
+	result["ethertype"] = uint16(ethType)
+	for k, v := range ETHERTYPE_MAP {
+		if uint32(ethType) == v {
+			result["ethertype"], _ = ygot.EnumName(k)
+			break
+		}
+	}
+	return result, nil
+}
+
+var YangToDb_acl_forwarding_action_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[ACL_PACKET_ACTION_FIELD] = ""
+		return res_map, nil
+	}
+	action, _ := inParams.param.(ocbinds.E_OpenconfigAcl_FORWARDING_ACTION)
+	switch action {
+	case ocbinds.OpenconfigAcl_FORWARDING_ACTION_ACCEPT:
+		res_map[ACL_PACKET_ACTION_FIELD] = "FORWARD"
+	case ocbinds.OpenconfigAcl_FORWARDING_ACTION_DROP, ocbinds.OpenconfigAcl_FORWARDING_ACTION_REJECT:
+		res_map[ACL_PACKET_ACTION_FIELD] = "DROP"
+	}
+	return res_map, nil
+}
+
+var DbToYang_acl_forwarding_action_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	entry, ok := getAclRuleEntry(inParams)
+	if !ok || !entry.Has(ACL_PACKET_ACTION_FIELD) {
+		return result, nil
+	}
+	if entry.Get(ACL_PACKET_ACTION_FIELD) == "FORWARD" {
+		result["forwarding-action"] = "ACCEPT"
+	} else {
+		result["forwarding-action"] = "DROP"
+	}
+	return result, nil
+}
+
+/* ACL keys bound in one direction on an interface of the request */
+type aclBinding struct {
+	ifName string
+	stage  string
+	aclKey string
+}
+
+func getAclBindingsFromYgot(aclObj *ocbinds.OpenconfigAcl_Acl) []aclBinding {
+	var bindings []aclBinding
+	if aclObj == nil || aclObj.Interfaces == nil {
+		return bindings
+	}
+	for intfId, intf := range aclObj.Interfaces.Interface {
+		if intf == nil {
+			continue
+		}
+		ifName := intfId
+		if intf.InterfaceRef != nil && intf.InterfaceRef.Config != nil && intf.InterfaceRef.Config.Interface != nil {
+			ifName = *intf.InterfaceRef.Config.Interface
+		}
+		if intf.IngressAclSets != nil {
+			for k := range intf.IngressAclSets.IngressAclSet {
+				aclType, _ := ygot.EnumName(k.Type)
+				bindings = append(bindings, aclBinding{ifName, ACL_STAGE_INGRESS, getAclKeyStrFromOCKey(k.SetName, aclType)})
+			}
+		}
+		if intf.EgressAclSets != nil {
+			for k := range intf.EgressAclSets.EgressAclSet {
+				aclType, _ := ygot.EnumName(k.Type)
+				bindings = append(bindings, aclBinding{ifName, ACL_STAGE_EGRESS, getAclKeyStrFromOCKey(k.SetName, aclType)})
+			}
+		}
+	}
+	return bindings
+}
+
+// YangToDb_acl_port_bindings_xfmr maps the interface bindings to the ports
+// and stage of the ACL_TABLE entries. An ACL is either INGRESS or EGRESS.
+var YangToDb_acl_port_bindings_xfmr SubTreeXfmrYangToDb = func(inParams XfmrParams) (map[string]map[string]db.Value, error) {
+	res_map := make(map[string]map[string]db.Value)
+	aclTs := &db.TableSpec{Name: ACL_TABLE}
+
+	if inParams.oper == DELETE {
+		return aclPortBindingsDelete(inParams)
+	}
+
+	aclObj := getAclRoot(inParams.ygRoot)
+	aclMap := make(map[string]db.Value)
+	for _, b := range getAclBindingsFromYgot(aclObj) {
+		aclEntry, ok := aclMap[b.aclKey]
+		if !ok {
+			aclEntry = db.Value{Field: make(map[string]string)}
+			dbAcl, err := inParams.d.GetEntry(aclTs, db.Key{Comp: []string{b.aclKey}})
+			if err != nil && !isAclInRequest(aclObj, b.aclKey) {
+				aclName, _ := getOCAclKeysFromStrKey(b.aclKey)
+				return res_map, tlerr.NotFound("Acl %s is not configured", aclName)
+			}
+			dbStage := dbAcl.Get(ACL_STAGE_FIELD)
+			if inParams.oper != REPLACE && len(dbAcl.GetList(ACL_PORTS_FIELD)) > 0 && len(dbStage) > 0 && dbStage != b.stage {
+				return res_map, tlerr.InvalidArgs("Acl direction of %s not allowed when it is already configured as %s", b.stage, dbStage)
+			}
+			/* Replace of bindings keeps the other fields of the ACL */
+			if inParams.oper == REPLACE && strings.HasPrefix(inParams.requestUri, OC_ACL_XPATH+"/interfaces") {
+				for field, val := range dbAcl.Field {
+					if field != ACL_PORTS_FIELD+"@" && field != ACL_STAGE_FIELD {
+						aclEntry.Field[field] = val
+					}
+				}
+			}
+		}
+		if stage := aclEntry.Get(ACL_STAGE_FIELD); len(stage) > 0 && stage != b.stage {
+			return res_map, tlerr.InvalidArgs("Acl direction of %s not allowed when it is already configured as %s", b.stage, stage)
+		}
+		ports := aclEntry.GetList(ACL_PORTS_FIELD)
+		if !contains(ports, b.ifName) {
+			ports = append(ports, b.ifName)
+		}
+		aclEntry.SetList(ACL_PORTS_FIELD, ports)
+		aclEntry.Set(ACL_STAGE_FIELD, b.stage)
+		aclMap[b.aclKey] = aclEntry
+	}
+
+	if len(aclMap) > 0 {
+		res_map[ACL_TABLE] = aclMap
+	}
+	log.V(3).Info("YangToDb_acl_port_bindings_xfmr: ", res_map)
+	return res_map, nil
+}
+
+func isAclInRequest(aclObj *ocbinds.OpenconfigAcl_Acl, aclKey string) bool {
+	if aclObj == nil || aclObj.AclSets == nil {
+		return false
+	}
+	for k := range aclObj.AclSets.AclSet {
+		aclType, _ := ygot.EnumName(k.Type)
+		if getAclKeyStrFromOCKey(k.Name, aclType) == aclKey {
+			return true
+		}
+	}
+	return false
+}
+
+/* Removes the interfaces of the request from the ACL bindings, or all bindings */
+func aclPortBindingsDelete(inParams XfmrParams) (map[string]map[string]db.Value, error) {
+	res_map := make(map[string]map[string]db.Value)
+	aclTs := &db.TableSpec{Name: ACL_TABLE}
+	pathInfo := NewPathInfo(inParams.uri)
+	ifName := pathInfo.Var("id")
+	setKey := ""
+	if setName := pathInfo.Var("set-name"); len(setName) > 0 {
+		setKey = getAclKeyStrFromOCKey(setName, pathInfo.Var("type"))
+	}
+	direction := ""
+	if strings.Contains(pathInfo.Template, "/ingress-acl-sets") {
+		direction = ACL_STAGE_INGRESS
+	} else if strings.Contains(pathInfo.Template, "/egress-acl-sets") {
+		direction = ACL_STAGE_EGRESS
+	}
+
+	aclKeys, err := inParams.d.GetKeys(aclTs)
+	if err != nil {
+		return res_map, err
+	}
+	aclMap := make(map[string]db.Value)
+	for _, key := range aclKeys {
+		aclKey := key.Get(0)
+		aclEntry, err := inParams.d.GetEntry(aclTs, key)
+		if err != nil {
+			return res_map, err
+		}
+		ports := aclEntry.GetList(ACL_PORTS_FIELD)
+		if len(ports) == 0 || (len(ifName) > 0 && !contains(ports, ifName)) {
+			continue
+		}
+		if len(setKey) > 0 && setKey != aclKey {
+			continue
+		}
+		stage := aclEntry.Get(ACL_STAGE_FIELD)
+		if len(direction) > 0 && stage != direction {
+			if len(setKey) > 0 {
+				aclName, _ := getOCAclKeysFromStrKey(aclKey)
+				return res_map, tlerr.InvalidArgs("Acl %s is not %s", aclName, strings.Title(strings.ToLower(direction)))
+			}
+			continue
+		}
+		if len(ifName) == 0 || len(ports) == 1 {
+			aclMap[aclKey] = db.Value{Field: map[string]string{ACL_PORTS_FIELD + "@": "", ACL_STAGE_FIELD: ""}}
+		} else {
+			aclMap[aclKey] = db.Value{Field: map[string]string{ACL_PORTS_FIELD + "@": ifName}}
+		}
+	}
+
+	if len(aclMap) > 0 {
+		res_map[ACL_TABLE] = aclMap
+	}
+	log.V(3).Info("aclPortBindingsDelete: ", res_map)
+	return res_map, nil
+}
+
+var DbToYang_acl_port_bindings_xfmr SubTreeXfmrDbToYang = func(inParams XfmrParams) error {
+	aclTs := &db.TableSpec{Name: ACL_TABLE}
+	ruleTs := &db.TableSpec{Name: ACL_RULE_TABLE}
+	configDb := inParams.dbs[db.ConfigDB]
+	pathInfo := NewPathInfo(inParams.uri)
+	ifName := pathInfo.Var("id")
+	setName := pathInfo.Var("set-name")
+	setKey := ""
+	if len(setName) > 0 {
+		setKey = getAclKeyStrFromOCKey(setName, pathInfo.Var("type"))
+	}
+	seqId := pathInfo.Var("sequence-id")
+	direction := ""
+	if strings.Contains(pathInfo.Template, "/ingress-acl-sets") {
+		direction = ACL_STAGE_INGRESS
+	} else if strings.Contains(pathInfo.Template, "/egress-acl-sets") {
+		direction = ACL_STAGE_EGRESS
+	}
+
+	aclObj := getAclRoot(inParams.ygRoot)
+	if aclObj == nil {
+		return tlerr.InvalidArgs("Acl container not initialized")
+	}
+	if aclObj.Interfaces == nil {
+		aclObj.Interfaces = &ocbinds.OpenconfigAcl_Acl_Interfaces{}
+	}
+
+	aclKeys, err := configDb.GetKeys(aclTs)
+	if err != nil {
+		return err
+	}
+	bindingFound := false
+	for _, key := range aclKeys {
+		aclKey := key.Get(0)
+		if len(setKey) > 0 && setKey != aclKey {
+			continue
+		}
+		aclEntry, err := configDb.GetEntry(aclTs, key)
+		if err != nil {
+			return err
+		}
+		stage := aclEntry.Get(ACL_STAGE_FIELD)
+		if len(direction) > 0 && stage != direction {
+			continue
+		}
+		aclName, aclTypeName := getOCAclKeysFromStrKey(aclKey)
+		aclType, err := getAclTypeOCEnumFromName(aclTypeName)
+		if err != nil {
+			continue
+		}
+
+		var seqIds []uint32
+		ruleKeys, err := configDb.GetKeysPattern(ruleTs, db.Key{Comp: []string{aclKey, ACL_RULE_PREFIX + "*"}})
+		if err != nil {
+			return err
+		}
+		for _, ruleKey := range ruleKeys {
+			ruleSeq := strings.TrimPrefix(ruleKey.Get(1), ACL_RULE_PREFIX)
+			if len(seqId) > 0 && ruleSeq != seqId {
+				continue
+			}
+			if id, err := strconv.ParseUint(ruleSeq, 10, 32); err == nil {
+				seqIds = append(seqIds, uint32(id))
+			}
+		}
+		if len(seqId) > 0 && len(seqIds) == 0 {
+			return tlerr.NotFound("Rule %s of Acl %s is not configured", seqId, aclName)
+		}
+
+		for _, port := range aclEntry.GetList(ACL_PORTS_FIELD) {
+			if len(port) == 0 || (len(ifName) > 0 && port != ifName) {
+				continue
+			}
+			intfObj, ok := aclObj.Interfaces.Interface[port]
+			if !ok {
+				intfObj, _ = aclObj.Interfaces.NewInterface(port)
+			}
+			ygot.BuildEmptyTree(intfObj)
+			intfObj.Config.Id = intfObj.Id
+			intfObj.State.Id = intfObj.Id
+
+			fillAclBinding(inParams, intfObj, stage, aclKey, aclName, aclType, seqIds)
+			bindingFound = true
+		}
+	}
+
+	if !bindingFound && len(setKey) > 0 {
+		return tlerr.NotFound("Acl %s not binded with %s", setName, ifName)
+	}
+	if !bindingFound && len(ifName) > 0 {
+		intfObj, ok := aclObj.Interfaces.Interface[ifName]
+		if !ok {
+			intfObj, _ = aclObj.Interfaces.NewInterface(ifName)
+		}
+		ygot.BuildEmptyTree(intfObj)
+		intfObj.Config.Id = intfObj.Id
+		intfObj.State.Id = intfObj.Id
+	}
+	return nil
+}
+
+// fillAclBinding fills the acl-set bound to the interface at the stage,
+// along with the counters of its rules seqIds
+func fillAclBinding(inParams XfmrParams, intfObj *ocbinds.OpenconfigAcl_Acl_Interfaces_Interface, stage string, aclKey string, aclName string, aclType ocbinds.E_OpenconfigAcl_ACL_TYPE, seqIds []uint32) {
+	setName := aclName
+	switch stage {
+	case ACL_STAGE_INGRESS:
+		aclSetKey := ocbinds.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key{SetName: aclName, Type: aclType}
+		aclSet, ok := intfObj.IngressAclSets.IngressAclSet[aclSetKey]
+		if !ok {
+			aclSet, _ = intfObj.IngressAclSets.NewIngressAclSet(aclName, aclType)
+		}
+		ygot.BuildEmptyTree(aclSet)
+		aclSet.Config.SetName, aclSet.Config.Type = &setName, aclType
+		aclSet.State.SetName, aclSet.State.Type = &setName, aclType
+		for _, seqId := range seqIds {
+			entry, ok := aclSet.AclEntries.AclEntry[seqId]
+			if !ok {
+				entry, _ = aclSet.AclEntries.NewAclEntry(seqId)
+			}
+			ygot.BuildEmptyTree(entry)
+			entry.State.SequenceId, entry.State.MatchedPackets, entry.State.MatchedOctets = getAclEntryState(inParams, aclKey, seqId)
+		}
+	case ACL_STAGE_EGRESS:
+		aclSetKey := ocbinds.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Key{SetName: aclName, Type: aclType}
+		aclSet, ok := intfObj.EgressAclSets.EgressAclSet[aclSetKey]
+		if !ok {
+			aclSet, _ = intfObj.EgressAclSets.NewEgressAclSet(aclName, aclType)
+		}
+		ygot.BuildEmptyTree(aclSet)
+		aclSet.Config.SetName, aclSet.Config.Type = &setName, aclType
+		aclSet.State.SetName, aclSet.State.Type = &setName, aclType
+		for _, seqId := range seqIds {
+			entry, ok := aclSet.AclEntries.AclEntry[seqId]
+			if !ok {
+				entry, _ = aclSet.AclEntries.NewAclEntry(seqId)
+			}
+			ygot.BuildEmptyTree(entry)
+			entry.State.SequenceId, entry.State.MatchedPackets, entry.State.MatchedOctets = getAclEntryState(inParams, aclKey, seqId)
+		}
+	}
+}
+
+// getAclEntryState returns the sequence-id and the counters of the rule, for the acl-entry state
+func getAclEntryState(inParams XfmrParams, aclKey string, seqId uint32) (*uint32, *uint64, *uint64) {
+	packets, octets := getAclEntryCounters(inParams, aclKey, ACL_RULE_PREFIX+strconv.FormatUint(uint64(seqId), 10))
+	return &seqId, &packets, &octets
+}
+
+// getAclTypeOCEnumFromName returns the ACL_TYPE enum from name
+func getAclTypeOCEnumFromName(val string) (ocbinds.E_OpenconfigAcl_ACL_TYPE, error) {
+	switch strings.TrimPrefix(val, OC_ACL_MODULE_PREFIX) {
+	case OC_ACL_TYPE_IPV4:
+		return ocbinds.OpenconfigAcl_ACL_TYPE_ACL_IPV4, nil
+	case OC_ACL_TYPE_IPV6:
+		return ocbinds.OpenconfigAcl_ACL_TYPE_ACL_IPV6, nil
+	case OC_ACL_TYPE_L2:
+		return ocbinds.OpenconfigAcl_ACL_TYPE_ACL_L2, nil
+	default:
+		return ocbinds.OpenconfigAcl_ACL_TYPE_UNSET,
+			tlerr.NotSupported("ACL Type '%s' not supported", val)
+	}
+}
+
+/* Bindings are not tracked for on-change; they are sampled from ACL_TABLE */
+var Subscribe_acl_port_bindings_xfmr SubTreeXfmrSubscribe = func(inParams XfmrSubscInParams) (XfmrSubscOutParams, error) {
+	var result XfmrSubscOutParams
+	if inParams.subscProc == TRANSLATE_SUBSCRIBE {
+		result.dbDataMap = RedisDbSubscribeMap{db.ConfigDB: {ACL_TABLE: {"*": {}}}}
+		result.onChange = OnchangeDisable
+		result.nOpts = &notificationOpts{pType: Sample}
+		result.needCache = true
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package transformer
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	log "github.com/golang/glog"
+)
+
+const (
+	/* COUNTERS_DB ACL counter tables */
+	ACL_COUNTER_RULE_MAP      = "ACL_COUNTER_RULE_MAP"
+	ACL_COUNTERS_TBL          = "COUNTERS"
+	ACL_COUNTERS_BASELINE_TBL = "ACL_COUNTERS_BASELINE"
+
+	ACL_COUNTER_PACKETS = "SAI_ACL_COUNTER_ATTR_PACKETS"
+	ACL_COUNTER_BYTES   = "SAI_ACL_COUNTER_ATTR_BYTES"
+
+	/* ACL_COUNTER_RULE_MAP field is "<acl table>:<rule>" */
+	ACL_COUNTER_RULE_MAP_SEPARATOR = ":"
+)
+
+func init() {
+	XlateFuncBind("rpc_clear_acl_counters_cb", rpc_clear_acl_counters_cb)
+}
+
+// GetAclRuleCounters returns the matched packets and octets of an ACL rule,
+// relative to the baseline recorded by the last counter clear. aclKey is the
+// ACL_TABLE key and ruleName the ACL_RULE rule name (e.g. "RULE_1").
+func GetAclRuleCounters(d *db.DB, aclKey string, ruleName string) (uint64, uint64, error) {
+	oid, err := d.GetMap(&db.TableSpec{Name: ACL_COUNTER_RULE_MAP}, aclKey+ACL_COUNTER_RULE_MAP_SEPARATOR+ruleName)
+	if err != nil || len(oid) == 0 {
+		log.V(3).Infof("ACL counter oid not found for %s|%s", aclKey, ruleName)
+		return 0, 0, tlerr.NotFound("Counters not found for ACL rule %s|%s", aclKey, ruleName)
+	}
+
+	counters, err := d.GetEntry(&db.TableSpec{Name: ACL_COUNTERS_TBL}, db.Key{Comp: []string{oid}})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	packets := parseAclCounter(counters, ACL_COUNTER_PACKETS)
+	octets := parseAclCounter(counters, ACL_COUNTER_BYTES)
+
+	baseline, err := d.GetEntry(&db.TableSpec{Name: ACL_COUNTERS_BASELINE_TBL}, db.Key{Comp: []string{oid}})
+	if err == nil {
+		packets = subtractAclCounterBaseline(packets, parseAclCounter(baseline, ACL_COUNTER_PACKETS))
+		octets = subtractAclCounterBaseline(octets, parseAclCounter(baseline, ACL_COUNTER_BYTES))
+	}
+
+	return packets, octets, nil
+}
+
+// ClearAclCounters records the current counters of an ACL rule as the
+// baseline for subsequent reads. All rules of the ACL are cleared when
+// ruleName is empty, and all ACLs when aclKey is empty too. Hardware
+// counters are left untouched.
+func ClearAclCounters(d *db.DB, aclKey string, ruleName string) error {
+	ruleMap, err := d.GetMapAll(&db.TableSpec{Name: ACL_COUNTER_RULE_MAP})
+	if err != nil {
+		log.Infof("ACL_COUNTER_RULE_MAP get failed; err=%v", err)
+		return tlerr.NotFound("ACL counters not available")
+	}
+
+	var prefix string
+	if len(aclKey) != 0 {
+		prefix = aclKey + ACL_COUNTER_RULE_MAP_SEPARATOR
+	}
+
+	found := false
+	for rule, oid := range ruleMap.Field {
+		if !strings.HasPrefix(rule, prefix) {
+			continue
+		}
+		if len(ruleName) != 0 && rule != prefix+ruleName {
+			continue
+		}
+		found = true
+
+		counters, err := d.GetEntry(&db.TableSpec{Name: ACL_COUNTERS_TBL}, db.Key{Comp: []string{oid}})
+		if err != nil {
+			log.Infof("Counters not found for ACL rule %s (%s)", rule, oid)
+			continue
+		}
+
+		baseline := db.Value{Field: map[string]string{
+			ACL_COUNTER_PACKETS: strconv.FormatUint(parseAclCounter(counters, ACL_COUNTER_PACKETS), 10),
+			ACL_COUNTER_BYTES:   strconv.FormatUint(parseAclCounter(counters, ACL_COUNTER_BYTES), 10),
+		}}
+		if err = d.SetEntry(&db.TableSpec{Name: ACL_COUNTERS_BASELINE_TBL}, db.Key{Comp: []string{oid}}, baseline); err != nil {
+			return err
+		}
+	}
+
+	if !found && len(aclKey) != 0 {
+		if len(ruleName) != 0 {
+			return tlerr.NotFound("Counters not found for ACL rule %s|%s", aclKey, ruleName)
+		}
+		return tlerr.NotFound("Counters not found for ACL %s", aclKey)
+	}
+	return nil
+}
+
+func parseAclCounter(entry db.Value, field string) uint64 {
+	val, err := strconv.ParseUint(entry.Get(field), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+// Counters below the baseline indicate a hardware counter reset
+// (e.g. rule re-programmed); report the raw value in that case.
+func subtractAclCounterBaseline(val, baseline uint64) uint64 {
+	if val < baseline {
+		return val
+	}
+	return val - baseline
+}
+
+var rpc_clear_acl_counters_cb RpcCallpoint = func(body []byte, dbs [db.MaxDB]*db.DB) ([]byte, error) {
+	var operand struct {
+		Input struct {
+			AclName  string `json:"aclname"`
+			RuleName string `json:"rulename"`
+		} `json:"sonic-acl:input"`
+	}
+
+	if err := json.Unmarshal(body, &operand); err != nil {
+		log.Errorf("Failed to parse rpc input; err=%v", err)
+		return nil, tlerr.InvalidArgs("Invalid rpc input")
+	}
+
+	if len(operand.Input.RuleName) != 0 && len(operand.Input.AclName) == 0 {
+		return nil, tlerr.InvalidArgs("aclname is required when rulename is specified")
+	}
+
+	var result struct {
+		Output struct {
+			Status string `json:"status"`
+		} `json:"sonic-acl:output"`
+	}
+
+	err := ClearAclCounters(dbs[db.CountersDB], operand.Input.AclName, operand.Input.RuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Output.Status = "Success"
+	return json.Marshal(&result)
+}
@@ -69,6 +69,13 @@ func init() {
 	XlateFuncBind("intf_subintfs_table_xfmr", intf_subintfs_table_xfmr)
 	XlateFuncBind("YangToDb_subif_index_xfmr", YangToDb_subif_index_xfmr)
 	XlateFuncBind("DbToYang_subif_index_xfmr", DbToYang_subif_index_xfmr)
+	XlateFuncBind("YangToDb_subintf_enabled_xfmr", YangToDb_subintf_enabled_xfmr)
+	XlateFuncBind("DbToYang_subintf_enabled_xfmr", DbToYang_subintf_enabled_xfmr)
+	XlateFuncBind("DbToYang_subintf_admin_status_xfmr", DbToYang_subintf_admin_status_xfmr)
+	XlateFuncBind("YangToDb_subintf_vlan_id_xfmr", YangToDb_subintf_vlan_id_xfmr)
+	XlateFuncBind("DbToYang_subintf_vlan_id_xfmr", DbToYang_subintf_vlan_id_xfmr)
+	XlateFuncBind("DbToYang_subintf_get_counters_xfmr", DbToYang_subintf_get_counters_xfmr)
+	XlateFuncBind("Subscribe_subintf_get_counters_xfmr", Subscribe_subintf_get_counters_xfmr)
 	XlateFuncBind("DbToYangPath_intf_ip_path_xfmr", DbToYangPath_intf_ip_path_xfmr)
 	XlateFuncBind("Subscribe_intf_ip_addr_xfmr", Subscribe_intf_ip_addr_xfmr)
 
@@ -90,9 +97,12 @@ const (
 )
 
 const (
-	PIPE     = "|"
-	COLON    = ":"
-	ETHERNET = "Eth"
+	PIPE        = "|"
+	COLON       = ":"
+	ETHERNET    = "Eth"
+	LOOPBACK    = "Loopback"
+	VLAN        = "Vlan"
+	SUBINTF_SEP = "."
 )
 
 type TblData struct {
@@ -124,12 +134,29 @@ var IntfTypeTblMap = map[E_InterfaceType]IntfTblData{
 		stateDb:     TblData{portTN: "PORT_TABLE", intfTN: "INTERFACE_TABLE", keySep: PIPE},
 		CountersHdl: CounterData{OIDTN: "COUNTERS_PORT_NAME_MAP", CountersTN: "COUNTERS", PopulateCounters: populatePortCounters},
 	},
+	IntfTypeLoopback: IntfTblData{
+		cfgDb:   TblData{portTN: "LOOPBACK_INTERFACE", intfTN: "LOOPBACK_INTERFACE", keySep: PIPE},
+		appDb:   TblData{portTN: "INTF_TABLE", intfTN: "INTF_TABLE", keySep: COLON},
+		stateDb: TblData{portTN: "INTERFACE_TABLE", intfTN: "INTERFACE_TABLE", keySep: PIPE},
+	},
+	IntfTypeVlan: IntfTblData{
+		cfgDb:       TblData{portTN: "VLAN", memberTN: "VLAN_MEMBER", intfTN: "VLAN_INTERFACE", keySep: PIPE},
+		appDb:       TblData{portTN: "VLAN_TABLE", memberTN: "VLAN_MEMBER_TABLE", intfTN: "INTF_TABLE", keySep: COLON},
+		stateDb:     TblData{portTN: "VLAN_TABLE", memberTN: "VLAN_MEMBER_TABLE", intfTN: "INTERFACE_TABLE", keySep: PIPE},
+		CountersHdl: CounterData{OIDTN: "COUNTERS_RIF_NAME_MAP", CountersTN: "COUNTERS", PopulateCounters: populateRifCounters},
+	},
+	IntfTypeVlanSubIntf: IntfTblData{
+		cfgDb:       TblData{portTN: "VLAN_SUB_INTERFACE", intfTN: "VLAN_SUB_INTERFACE", keySep: PIPE},
+		appDb:       TblData{portTN: "INTF_TABLE", intfTN: "INTF_TABLE", keySep: COLON},
+		stateDb:     TblData{portTN: "INTERFACE_TABLE", intfTN: "INTERFACE_TABLE", keySep: PIPE},
+		CountersHdl: CounterData{OIDTN: "COUNTERS_RIF_NAME_MAP", CountersTN: "COUNTERS", PopulateCounters: populateRifCounters},
+	},
 }
 
 var dbIdToTblMap = map[db.DBNum][]string{
-	db.ConfigDB: {"PORT"},
-	db.ApplDB:   {"PORT_TABLE"},
-	db.StateDB:  {"PORT_TABLE"},
+	db.ConfigDB: {"PORT", "LOOPBACK_INTERFACE", "VLAN"},
+	db.ApplDB:   {"PORT_TABLE", "VLAN_TABLE"},
+	db.StateDB:  {"PORT_TABLE", "VLAN_TABLE"},
 }
 
 var intfOCToSpeedMap = map[ocbinds.E_OpenconfigIfEthernet_ETHERNET_SPEED]string{
@@ -151,8 +178,11 @@ var intfOCToSpeedMap = map[ocbinds.E_OpenconfigIfEthernet_ETHERNET_SPEED]string{
 type E_InterfaceType int64
 
 const (
-	IntfTypeUnset    E_InterfaceType = 0
-	IntfTypeEthernet E_InterfaceType = 1
+	IntfTypeUnset       E_InterfaceType = 0
+	IntfTypeEthernet    E_InterfaceType = 1
+	IntfTypeLoopback    E_InterfaceType = 2
+	IntfTypeVlan        E_InterfaceType = 3
+	IntfTypeVlanSubIntf E_InterfaceType = 4
 )
 
 type E_InterfaceSubType int64
@@ -164,14 +194,67 @@ const (
 func getIntfTypeByName(name string) (E_InterfaceType, E_InterfaceSubType, error) {
 
 	var err error
-	if strings.HasPrefix(name, ETHERNET) {
+	if strings.Contains(name, SUBINTF_SEP) {
+		parent, _, perr := splitSubIntfName(name)
+		if perr == nil && strings.HasPrefix(parent, ETHERNET) {
+			return IntfTypeVlanSubIntf, IntfSubTypeUnset, nil
+		}
+		err = errors.New("Interface name prefix not matched with supported types")
+		return IntfTypeUnset, IntfSubTypeUnset, err
+	} else if strings.HasPrefix(name, ETHERNET) {
 		return IntfTypeEthernet, IntfSubTypeUnset, err
+	} else if strings.HasPrefix(name, LOOPBACK) {
+		return IntfTypeLoopback, IntfSubTypeUnset, err
+	} else if strings.HasPrefix(name, VLAN) {
+		return IntfTypeVlan, IntfSubTypeUnset, err
 	} else {
 		err = errors.New("Interface name prefix not matched with supported types")
 		return IntfTypeUnset, IntfSubTypeUnset, err
 	}
 }
 
+/* Subinterface index 0 is the parent interface itself, any other index is a VLAN tagged subinterface */
+func isVlanSubIntfIdx(idx string) bool {
+	return idx != "" && idx != "0" && idx != "*"
+}
+
+/* Get the VLAN_SUB_INTERFACE name (<parent>.<index>) of a subinterface */
+func getSubIntfName(ifName string, idx string) string {
+	return ifName + SUBINTF_SEP + idx
+}
+
+/* Split a VLAN_SUB_INTERFACE name into the parent interface name and subinterface index */
+func splitSubIntfName(subIfName string) (string, uint32, error) {
+	sepIdx := strings.LastIndex(subIfName, SUBINTF_SEP)
+	if sepIdx <= 0 {
+		return subIfName, 0, errors.New("Invalid subinterface name " + subIfName)
+	}
+	i64, err := strconv.ParseUint(subIfName[sepIdx+1:], 10, 32)
+	if err != nil {
+		return subIfName, 0, errors.New("Invalid subinterface name " + subIfName)
+	}
+	return subIfName[:sepIdx], uint32(i64), nil
+}
+
+/* Get the DB name of the interface the uri refers to, which is <parent>.<index> for VLAN tagged subinterfaces */
+func getIntfNameFromUri(pathInfo *PathInfo) string {
+	ifName := pathInfo.Var("name")
+	if idx := pathInfo.Var("index"); isVlanSubIntfIdx(idx) && ifName != "*" {
+		return getSubIntfName(ifName, idx)
+	}
+	return ifName
+}
+
+/* Only Ethernet interfaces can be the parent of a VLAN tagged subinterface */
+func validateSubIntfParent(ifName string) error {
+	intfType, _, err := getIntfTypeByName(ifName)
+	if err != nil || intfType != IntfTypeEthernet {
+		errStr := "Subinterfaces not supported for interface " + ifName
+		return tlerr.NotSupported(errStr)
+	}
+	return nil
+}
+
 func getIntfsRoot(s *ygot.GoStruct) *ocbinds.OpenconfigInterfaces_Interfaces {
 	deviceObj := (*s).(*ocbinds.Device)
 	return deviceObj.Interfaces
@@ -208,6 +291,16 @@ func performIfNameKeyXfmrOp(inParams *XfmrParams, requestUriPath *string, ifName
 				errStr := "Physical Interface: " + *ifName + " cannot be deleted"
 				err = tlerr.InvalidArgsError{Format: errStr}
 				return err
+			case IntfTypeLoopback, IntfTypeVlan:
+				err = validateIntfExists(inParams.d, IntfTypeTblMap[ifType].cfgDb.portTN, *ifName)
+				if err != nil {
+					// Not returning error from here since mgmt infra will return "Resource not found" error in case of non existence entries
+					return nil
+				}
+				if ifType == IntfTypeVlan {
+					return validateVlanMembersNotExist(inParams.d, ifName)
+				}
+				return nil
 			default:
 				errStr := "Invalid interface for delete:" + *ifName
 				log.Error(errStr)
@@ -233,11 +326,49 @@ func performIfNameKeyXfmrOp(inParams *XfmrParams, requestUriPath *string, ifName
 					return tlerr.NotSupported(err_str)
 				}
 			}
+		} else if ifType == IntfTypeLoopback || ifType == IntfTypeVlan {
+			err = validateVirtualIntfName(*ifName, ifType)
 		}
 	}
 	return err
 }
 
+/* Validate the name of a Loopback or Vlan interface, which is created on demand */
+func validateVirtualIntfName(ifName string, ifType E_InterfaceType) error {
+	var prefix string
+	var minId, maxId uint64
+
+	switch ifType {
+	case IntfTypeLoopback:
+		prefix, minId, maxId = LOOPBACK, 0, 16383
+	case IntfTypeVlan:
+		prefix, minId, maxId = VLAN, 1, 4094
+	default:
+		return tlerr.InvalidArgsError{Format: "Invalid interface type for " + ifName}
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(ifName, prefix), 10, 32)
+	if err != nil || id < minId || id > maxId {
+		errStr := fmt.Sprintf("Invalid interface name %s, expected %s<%d-%d>", ifName, prefix, minId, maxId)
+		return tlerr.InvalidArgsError{Format: errStr}
+	}
+	return nil
+}
+
+/* Vlan interface cannot be removed while ports are still members of the Vlan */
+func validateVlanMembersNotExist(d *db.DB, vlanName *string) error {
+	memberTN := IntfTypeTblMap[IntfTypeVlan].cfgDb.memberTN
+	memberKeys, err := d.GetKeysPattern(&db.TableSpec{Name: memberTN}, db.Key{Comp: []string{*vlanName, "*"}})
+	if err != nil {
+		return err
+	}
+	if len(memberKeys) > 0 {
+		errStr := "Vlan interface: " + *vlanName + " cannot be deleted, remove the member ports first"
+		return tlerr.InvalidArgsError{Format: errStr}
+	}
+	return nil
+}
+
 /* Validate whether intf exists in DB */
 func validateIntfExists(d *db.DB, intfTs string, ifName string) error {
 	if len(ifName) == 0 {
@@ -334,6 +465,13 @@ var intf_table_xfmr TableXfmrFunc = func(inParams XfmrParams) ([]string, error)
 		return tblList, errors.New("Invalid interface type IntfTypeUnset")
 	}
 	intTbl := IntfTypeTblMap[intfType]
+	isSubIntf := false
+	if strings.HasPrefix(targetUriPath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface") &&
+		isVlanSubIntfIdx(pathInfo.Var("index")) {
+		// IP config of VLAN tagged subinterfaces is kept in the VLAN_SUB_INTERFACE table
+		isSubIntf = true
+		intTbl = IntfTypeTblMap[IntfTypeVlanSubIntf]
+	}
 	if log.V(3) {
 		log.Info("TableXfmrFunc - targetUriPath : ", targetUriPath)
 		log.Info("TableXfmrFunc - targetUriXpath : ", targetUriXpath)
@@ -377,6 +515,21 @@ var intf_table_xfmr TableXfmrFunc = func(inParams XfmrParams) ([]string, error)
 		strings.HasPrefix(targetUriPath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/openconfig-if-ip:ipv6/addresses") ||
 		strings.HasPrefix(targetUriPath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv6/addresses") {
 		tblList = append(tblList, intTbl.cfgDb.intfTN)
+	} else if strings.HasPrefix(targetUriXpath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv4/config") ||
+		strings.HasPrefix(targetUriXpath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv4/state") {
+		if !isSubIntf {
+			// MTU of subinterface 0 is the MTU of the parent interface itself
+			if inParams.oper == GET || inParams.oper == SUBSCRIBE {
+				return nil, nil
+			}
+			errStr := "MTU configuration not supported for subinterface 0, configure the interface MTU instead"
+			return tblList, tlerr.NotSupported(errStr)
+		}
+		if strings.HasPrefix(targetUriXpath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv4/state") {
+			tblList = append(tblList, intTbl.appDb.portTN)
+		} else {
+			tblList = append(tblList, intTbl.cfgDb.portTN)
+		}
 	} else if inParams.oper == GET && strings.HasPrefix(targetUriXpath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv4/neighbors") ||
 		strings.HasPrefix(targetUriXpath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/ipv6/neighbors") {
 		tblList = append(tblList, "NONE")
@@ -423,15 +576,16 @@ var YangToDb_intf_tbl_key_xfmr KeyXfmrYangToDb = func(inParams XfmrParams) (stri
 
 	log.Infof("YangToDb_intf_tbl_key_xfmr: i32: %s", i32)
 
-	if i32 != 0 {
-		err_str := "Subinterfaces not supported"
-		return ifName, tlerr.NotSupported(err_str)
-	}
-
 	if ifName == "*" {
 		return ifName, nil
 	}
 
+	if i32 != 0 {
+		if err = validateSubIntfParent(ifName); err != nil {
+			return ifName, err
+		}
+	}
+
 	if ifName != "" {
 		log.Info("YangToDb_intf_tbl_key_xfmr: ifName: ", ifName)
 		intfType, _, ierr := getIntfTypeByName(ifName)
@@ -439,6 +593,10 @@ var YangToDb_intf_tbl_key_xfmr KeyXfmrYangToDb = func(inParams XfmrParams) (stri
 			log.Errorf("Extracting Interface type for Interface: %s failed!", ifName)
 			return "", tlerr.New(ierr.Error())
 		}
+		if intfType == IntfTypeVlanSubIntf {
+			errStr := "Subinterface " + ifName + " must be configured as a subinterface of its parent interface"
+			return "", tlerr.InvalidArgsError{Format: errStr}
+		}
 		err = performIfNameKeyXfmrOp(&inParams, &requestUriPath, &ifName, intfType, i32)
 		if err != nil {
 			return "", tlerr.InvalidArgsError{Format: err.Error()}
@@ -459,20 +617,13 @@ var DbToYang_intf_tbl_key_xfmr KeyXfmrDbToYang = func(inParams XfmrParams) (map[
 	requestUriPath := reqpathInfo.YangPath
 
 	log.Infof("DbToYang_intf_tbl_key_xfmr: inParams.uri: %s, pathInfo: %s, inParams.requestUri: %s", inParams.uri, pathInfo, requestUriPath)
-	idx := reqpathInfo.Var("index")
-	var i32 uint32
-	i32 = 0
 
-	if idx != "" {
-		i64, _ := strconv.ParseUint(idx, 10, 32)
-		i32 = uint32(i64)
-	}
-
-	log.Infof("DbToYang_intf_tbl_key_xfmr: i32: %s", i32)
-
-	if i32 != 0 {
-		err_str := "Subinterfaces not supported"
-		return res_map, tlerr.NotSupported(err_str)
+	if strings.Contains(inParams.key, PIPE) {
+		// L3 interface tables like LOOPBACK_INTERFACE also carry the "ifname|ip-prefix" keys, which are not interfaces
+		if log.V(3) {
+			log.Info("DbToYang_intf_tbl_key_xfmr: Skipping IP address key ", inParams.key)
+		}
+		return res_map, nil
 	}
 	log.Info("DbToYang_intf_tbl_key_xfmr: Interface Name = ", inParams.key)
 	res_map["name"] = inParams.key
@@ -590,6 +741,10 @@ var YangToDb_intf_mtu_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[st
 		}
 	}
 	intfType, _, _ := getIntfTypeByName(ifName)
+	if intfType == IntfTypeLoopback {
+		errStr := "MTU configuration not supported for Loopback interface: " + ifName
+		return res_map, tlerr.NotSupported(errStr)
+	}
 
 	if inParams.oper == DELETE {
 		log.Infof("Updating the Interface: %s with default MTU", ifName)
@@ -944,12 +1099,17 @@ var DbToYang_intf_eth_port_speed_xfmr FieldXfmrDbtoYang = func(inParams XfmrPara
 }
 
 func getIntfCountersTblKey(d *db.DB, ifKey string) (string, error) {
+	return getCountersOidByName(d, "COUNTERS_PORT_NAME_MAP", ifKey)
+}
+
+/* Get the COUNTERS table key (OID) of an interface from the given name map table */
+func getCountersOidByName(d *db.DB, oidTblName string, ifKey string) (string, error) {
 	var oid string
 
-	portOidCountrTblTs := &db.TableSpec{Name: "COUNTERS_PORT_NAME_MAP"}
+	portOidCountrTblTs := &db.TableSpec{Name: oidTblName}
 	ifCountInfo, err := d.GetMapAll(portOidCountrTblTs)
 	if err != nil {
-		log.Error("Port-OID (Counters) get for all the interfaces failed!")
+		log.Errorf("%s (Counters) get for all the interfaces failed!", oidTblName)
 		return oid, err
 	}
 
@@ -1213,15 +1373,18 @@ var Subscribe_intf_get_counters_xfmr SubTreeXfmrSubscribe = func(inParams XfmrSu
 		ifName := pathInfo.Var("name")
 		log.Info("Subscribe_intf_get_counters_xfmr: ifName: ", ifName)
 
+		oidTblName := "COUNTERS_PORT_NAME_MAP"
 		if ifName == "" || ifName == "*" {
 			if strings.HasPrefix(targetUriPath, "/openconfig-interfaces:interfaces/interface/openconfig-if-ethernet:ethernet/state/counters") {
 				ifName = "Eth" + "*"
 			} else {
 				ifName = "*"
 			}
+		} else if intfType, _, _ := getIntfTypeByName(ifName); len(IntfTypeTblMap[intfType].CountersHdl.OIDTN) > 0 {
+			oidTblName = IntfTypeTblMap[intfType].CountersHdl.OIDTN
 		}
 
-		result.dbDataMap = RedisDbSubscribeMap{db.CountersDB: {"COUNTERS_PORT_NAME_MAP": {"": {FIELD_CURSOR: ifName}}}}
+		result.dbDataMap = RedisDbSubscribeMap{db.CountersDB: {oidTblName: {"": {FIELD_CURSOR: ifName}}}}
 
 		log.Info("Subscribe_intf_eth_port_config_xfmr: result ", result)
 	}
@@ -1233,12 +1396,12 @@ var DbToYangPath_intf_get_counters_path_xfmr PathXfmrDbToYangFunc = func(params
 
 	intfRoot := "/openconfig-interfaces:interfaces/interface"
 
-	if params.tblName != "COUNTERS_PORT_NAME_MAP" {
+	if params.tblName != "COUNTERS_PORT_NAME_MAP" && params.tblName != "COUNTERS_RIF_NAME_MAP" {
 		log.Info("DbToYangPath_intf_get_counters_path_xfmr: from wrong table: ", params.tblName)
 		return nil
 	}
 
-	if (params.tblName == "COUNTERS_PORT_NAME_MAP") && (len(params.tblKeyComp) > 0) {
+	if len(params.tblKeyComp) > 0 {
 		params.ygPathKeys[intfRoot+"/name"] = params.tblKeyComp[0]
 	} else {
 		log.Info("DbToYangPath_intf_get_counters_path_xfmr, wrong param: tbl ", params.tblName, " key ", params.tblKeyComp)
@@ -1313,6 +1476,65 @@ var populatePortCounters PopulateIntfCounters = func(inParams XfmrParams, counte
 	return err
 }
 
+/* Populate interface counters from the router interface (RIF) counters, used by L3 only interfaces like Vlan */
+var populateRifCounters PopulateIntfCounters = func(inParams XfmrParams, counter interface{}) error {
+	pathInfo := NewPathInfo(inParams.uri)
+	ifName := pathInfo.Var("name")
+
+	targetUriPath := pathInfo.YangPath
+	countersPath := "/openconfig-interfaces:interfaces/interface/state/counters"
+
+	intfType, _, ierr := getIntfTypeByName(ifName)
+	if intfType == IntfTypeUnset || ierr != nil {
+		return errors.New("Invalid interface type IntfTypeUnset")
+	}
+	counter_val := counter.(*ocbinds.OpenconfigInterfaces_Interfaces_Interface_State_Counters)
+	cntPtrs := map[string]**uint64{
+		"in-octets":  &counter_val.InOctets,
+		"in-pkts":    &counter_val.InPkts,
+		"in-errors":  &counter_val.InErrors,
+		"out-octets": &counter_val.OutOctets,
+		"out-pkts":   &counter_val.OutPkts,
+		"out-errors": &counter_val.OutErrors,
+	}
+	return fillRifCounters(inParams.dbs[inParams.curDb], IntfTypeTblMap[intfType].CountersHdl, ifName,
+		strings.TrimPrefix(strings.TrimPrefix(targetUriPath, countersPath), "/"), cntPtrs)
+}
+
+var rifCntAttrMap = map[string]string{
+	"in-octets":  "SAI_ROUTER_INTERFACE_STAT_IN_OCTETS",
+	"in-pkts":    "SAI_ROUTER_INTERFACE_STAT_IN_PACKETS",
+	"in-errors":  "SAI_ROUTER_INTERFACE_STAT_IN_ERROR_PACKETS",
+	"out-octets": "SAI_ROUTER_INTERFACE_STAT_OUT_OCTETS",
+	"out-pkts":   "SAI_ROUTER_INTERFACE_STAT_OUT_PACKETS",
+	"out-errors": "SAI_ROUTER_INTERFACE_STAT_OUT_ERROR_PACKETS",
+}
+
+/* Fill the given counter leaves (all of them when leaf is empty) from the RIF counters of the interface */
+func fillRifCounters(d *db.DB, cntHdl CounterData, ifName string, leaf string, cntPtrs map[string]**uint64) error {
+	oid, oiderr := getCountersOidByName(d, cntHdl.OIDTN, ifName)
+	if oiderr != nil {
+		log.Info(oiderr)
+		return oiderr
+	}
+	cntTs := &db.TableSpec{Name: cntHdl.CountersTN}
+	entry, dbErr := d.GetEntry(cntTs, db.Key{Comp: []string{oid}})
+	if dbErr != nil {
+		log.Info("fillRifCounters : not able find the oid entry in DB Counters table")
+		return dbErr
+	}
+
+	for cntLeaf, val := range cntPtrs {
+		if leaf != "" && leaf != cntLeaf {
+			continue
+		}
+		if err := getCounters(&entry, rifCntAttrMap[cntLeaf], val); err != nil {
+			log.Info("Get RIF Counter failed :", rifCntAttrMap[cntLeaf])
+		}
+	}
+	return nil
+}
+
 var YangToDb_intf_counters_key KeyXfmrYangToDb = func(inParams XfmrParams) (string, error) {
 	pathInfo := NewPathInfo(inParams.uri)
 	intfName := pathInfo.Var("name")
@@ -1400,7 +1622,46 @@ var intf_post_xfmr PostXfmrFunc = func(inParams XfmrParams) (map[string]map[stri
 				log.Info("intf_post_xfmr inParams.subOpDataMap :", inParams.subOpDataMap)
 			}
 		}
-	} else if inParams.oper == UPDATE {
+
+		/* Vlan and Loopback interface delete also removes its IP and L3 interface entries from
+		   VLAN_INTERFACE or LOOPBACK_INTERFACE. IP keys "ifname|ip-prefix" are added first, same as
+		   intf_ip_addr_del does for other interfaces */
+		if xpath == "/openconfig-interfaces:interfaces/interface" {
+			ifName := NewPathInfo(inParams.requestUri).Var("name")
+			if intfType, _, _ := getIntfTypeByName(ifName); intfType == IntfTypeVlan || intfType == IntfTypeLoopback {
+				intfTN := IntfTypeTblMap[intfType].cfgDb.intfTN
+				if validateIntfExists(inParams.d, intfTN, ifName) == nil {
+					if retDbDataMap == nil {
+						retDbDataMap = make(map[string]map[string]db.Value)
+					}
+					if _, ok := retDbDataMap[intfTN]; !ok {
+						retDbDataMap[intfTN] = make(map[string]db.Value)
+					}
+					ipMap, _ := getIntfIpByName(inParams.d, intfTN, ifName, true, true, "")
+					for ip := range ipMap {
+						retDbDataMap[intfTN][ifName+"|"+ip] = db.Value{Field: make(map[string]string)}
+					}
+					retDbDataMap[intfTN][ifName] = db.Value{Field: make(map[string]string)}
+				}
+			}
+		}
+		return retDbDataMap, nil
+	}
+
+	/* Vlan interface entry needs the vlanid field, derive it from the interface name */
+	if vlanTbl, ok := retDbDataMap[IntfTypeTblMap[IntfTypeVlan].cfgDb.portTN]; ok {
+		for vlanName, vlanEntry := range vlanTbl {
+			if vlanEntry.Field == nil {
+				vlanEntry.Field = make(map[string]string)
+			}
+			if _, ok := vlanEntry.Field["vlanid"]; !ok {
+				vlanEntry.Field["vlanid"] = strings.TrimPrefix(vlanName, VLAN)
+			}
+			vlanTbl[vlanName] = vlanEntry
+		}
+	}
+
+	if inParams.oper == UPDATE {
 		if replace, ok := inParams.subOpDataMap[REPLACE]; ok {
 			if (*replace)[db.ConfigDB] != nil {
 				if portTable, ok := (*replace)[db.ConfigDB]["PORT"]; ok {
@@ -1450,13 +1711,10 @@ var intf_subintfs_table_xfmr TableXfmrFunc = func(inParams XfmrParams) ([]string
 	if inParams.oper == SUBSCRIBE {
 		var _intfTypeList []E_InterfaceType
 
-		if idx != "*" && idx != "0" {
-			err_str := "Subinterfaces not supported"
-			return tblList, tlerr.NotSupported(err_str)
-		}
-
-		if ifName == "*" {
-			_intfTypeList = append(_intfTypeList, IntfTypeEthernet)
+		if isVlanSubIntfIdx(idx) {
+			_intfTypeList = append(_intfTypeList, IntfTypeVlanSubIntf)
+		} else if ifName == "*" {
+			_intfTypeList = append(_intfTypeList, IntfTypeEthernet, IntfTypeLoopback, IntfTypeVlan)
 		} else {
 			_ifType, _, _err := getIntfTypeByName(ifName)
 			if _ifType == IntfTypeUnset || _err != nil {
@@ -1483,6 +1741,10 @@ var intf_subintfs_table_xfmr TableXfmrFunc = func(inParams XfmrParams) ([]string
 				(*inParams.dbDataMap)[db.ConfigDB]["SUBINTF_TBL"]["0"] = db.Value{Field: make(map[string]string)}
 				tblList = append(tblList, "SUBINTF_TBL")
 			}
+			// VLAN tagged subinterfaces of the parent interface are read from the VLAN_SUB_INTERFACE table
+			if inParams.oper == GET && validateSubIntfParent(ifName) == nil {
+				tblList = append(tblList, IntfTypeTblMap[IntfTypeVlanSubIntf].cfgDb.portTN)
+			}
 		}
 		log.Info("intf_subintfs_table_xfmr - Subinterface get operation ")
 	} else {
@@ -1493,9 +1755,11 @@ var intf_subintfs_table_xfmr TableXfmrFunc = func(inParams XfmrParams) ([]string
 				(*inParams.dbDataMap)[db.ConfigDB]["SUBINTF_TBL"]["0"].Field["NULL"] = "NULL"
 			}
 			tblList = append(tblList, "SUBINTF_TBL")
-		} else if idx != "0" {
-			err_str := "Subinterfaces not supported"
-			return tblList, tlerr.NotSupported(err_str)
+		} else {
+			if err := validateSubIntfParent(ifName); err != nil {
+				return tblList, err
+			}
+			tblList = append(tblList, IntfTypeTblMap[IntfTypeVlanSubIntf].cfgDb.portTN)
 		}
 		if log.V(3) {
 			log.Info("intf_subintfs_table_xfmr - Subinterface get operation ")
@@ -1524,9 +1788,17 @@ var YangToDb_intf_subintfs_xfmr KeyXfmrYangToDb = func(inParams XfmrParams) (str
 
 	idx := pathInfo.Var("index")
 
-	if idx != "0" && idx != "*" && idx != "" {
-		err_str := "Subinterfaces not supported"
-		return subintf_key, tlerr.NotSupported(err_str)
+	if isVlanSubIntfIdx(idx) {
+		if err = validateSubIntfParent(ifName); err != nil {
+			return subintf_key, err
+		}
+		subintf_key = getSubIntfName(ifName, idx)
+		if inParams.oper == GET || inParams.oper == DELETE {
+			// Subinterface list is a virtual table, hence the existence check is done here
+			if validateIntfExists(inParams.d, IntfTypeTblMap[IntfTypeVlanSubIntf].cfgDb.portTN, subintf_key) != nil {
+				return subintf_key, tlerr.NotFound("Resource not found")
+			}
+		}
 	} else if inParams.oper == SUBSCRIBE && idx == "0" {
 		subintf_key = ifName
 	} else { /* For get 0 index case & subscribe index * case */
@@ -1545,14 +1817,25 @@ var DbToYang_intf_subintfs_xfmr KeyXfmrDbToYang = func(inParams XfmrParams) (map
 	var idx string
 
 	idx = inParams.key
-	var i32 uint32
-	i32 = 0
 
 	rmap := make(map[string]interface{})
 	var err error
+	if strings.Contains(idx, SUBINTF_SEP) {
+		if strings.Contains(idx, PIPE) {
+			// VLAN_SUB_INTERFACE also carries the "subintf|ip-prefix" keys, which are not subinterfaces
+			return rmap, nil
+		}
+		parent, i32, serr := splitSubIntfName(idx)
+		if serr != nil || parent != NewPathInfo(inParams.uri).Var("name") {
+			// Subinterface of another parent interface
+			return rmap, nil
+		}
+		rmap["index"] = uint64(i32)
+		log.Info("DbToYang_intf_subintfs_xfmr rmap ", rmap)
+		return rmap, nil
+	}
 	i64, _ := strconv.ParseUint(idx, 10, 32)
-	i32 = uint32(i64)
-	if i32 != 0 {
+	if i64 != 0 {
 		log.Info("DbToYang_intf_subintfs_xfmr - rmap ", rmap)
 		err_str := "Subinterfaces not supported"
 		return rmap, tlerr.NotSupported(err_str)
@@ -1590,6 +1873,10 @@ var YangToDb_subif_index_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map
 	log.Info(uriIfName)
 	ifName := uriIfName
 
+	if isVlanSubIntfIdx(pathInfo.Var("index")) {
+		// Parent interface and index are part of the VLAN_SUB_INTERFACE key
+		return res_map, err
+	}
 	res_map["parent"] = ifName
 
 	log.Info("YangToDb_subif_index_xfmr: res_map:", res_map)
@@ -1603,15 +1890,213 @@ var DbToYang_subif_index_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map
 	id := pathInfo.Var("index")
 	log.Info("DbToYang_subif_index_xfmr: Sub-interface Index = ", id)
 	i64, _ := strconv.ParseUint(id, 10, 32)
-	i32 := uint32(i64)
-	if i32 != 0 {
-		err_str := "Subinterfaces not supported"
-		return res_map, tlerr.NotSupported(err_str)
-	}
 	res_map["index"] = i64
 	return res_map, nil
 }
 
+/* Get the VLAN_SUB_INTERFACE entry of a subinterface, or its APPL_DB INTF_TABLE entry for the state containers */
+func getSubIntfEntry(inParams XfmrParams, isState bool) (db.Value, error) {
+	intTbl := IntfTypeTblMap[IntfTypeVlanSubIntf]
+	if isState {
+		return inParams.dbs[db.ApplDB].GetEntry(&db.TableSpec{Name: intTbl.appDb.portTN}, db.Key{Comp: []string{inParams.key}})
+	}
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	if entry, ok := data[intTbl.cfgDb.portTN][inParams.key]; ok {
+		return entry, nil
+	}
+	return db.Value{}, errors.New("Subinterface not found : " + inParams.key)
+}
+
+var YangToDb_subintf_enabled_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+
+	pathInfo := NewPathInfo(inParams.uri)
+	if !isVlanSubIntfIdx(pathInfo.Var("index")) {
+		// Admin status of subinterface 0 is the admin status of the parent interface
+		return res_map, nil
+	}
+	if inParams.oper == DELETE {
+		res_map[PORT_ADMIN_STATUS] = ""
+		return res_map, nil
+	}
+
+	enabled, _ := inParams.param.(*bool)
+	if enabled == nil {
+		return res_map, nil
+	}
+	if *enabled {
+		res_map[PORT_ADMIN_STATUS] = "up"
+	} else {
+		res_map[PORT_ADMIN_STATUS] = "down"
+	}
+	return res_map, nil
+}
+
+var DbToYang_subintf_enabled_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	if !strings.Contains(inParams.key, SUBINTF_SEP) {
+		return result, nil
+	}
+	isState := strings.HasPrefix(NewPathInfo(inParams.uri).YangPath, "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/state")
+	entry, err := getSubIntfEntry(inParams, isState)
+	if err != nil {
+		log.Info("DbToYang_subintf_enabled_xfmr : ", err)
+		return result, nil
+	}
+	if adminStatus, ok := entry.Field[PORT_ADMIN_STATUS]; ok {
+		result["enabled"] = (adminStatus == "up")
+	}
+	return result, nil
+}
+
+var DbToYang_subintf_admin_status_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	if !strings.Contains(inParams.key, SUBINTF_SEP) {
+		return result, nil
+	}
+	entry, err := getSubIntfEntry(inParams, true)
+	if err != nil {
+		log.Info("DbToYang_subintf_admin_status_xfmr : ", err)
+		return result, nil
+	}
+	if adminStatus, ok := entry.Field[PORT_ADMIN_STATUS]; ok {
+		status := ocbinds.OpenconfigInterfaces_Interfaces_Interface_State_AdminStatus_DOWN
+		if adminStatus == "up" {
+			status = ocbinds.OpenconfigInterfaces_Interfaces_Interface_State_AdminStatus_UP
+		}
+		result["admin-status"] = ocbinds.E_OpenconfigInterfaces_Interfaces_Interface_State_AdminStatus.ΛMap(status)["E_OpenconfigInterfaces_Interfaces_Interface_State_AdminStatus"][int64(status)].Name
+	}
+	return result, nil
+}
+
+// YangToDb_subintf_vlan_id_xfmr maps the single tagged VLAN id of a subinterface to the VLAN_SUB_INTERFACE vlan field.
+var YangToDb_subintf_vlan_id_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+
+	pathInfo := NewPathInfo(inParams.uri)
+	if !isVlanSubIntfIdx(pathInfo.Var("index")) {
+		if inParams.oper == DELETE {
+			return res_map, nil
+		}
+		errStr := "VLAN id can be configured only on subinterfaces with non-zero index"
+		return res_map, tlerr.NotSupported(errStr)
+	}
+	if inParams.oper == DELETE {
+		res_map["vlan"] = ""
+		return res_map, nil
+	}
+
+	vlanId, _ := inParams.param.(*uint16)
+	if vlanId == nil {
+		return res_map, nil
+	}
+	res_map["vlan"] = strconv.FormatUint(uint64(*vlanId), 10)
+	return res_map, nil
+}
+
+var DbToYang_subintf_vlan_id_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	if !strings.Contains(inParams.key, SUBINTF_SEP) {
+		return result, nil
+	}
+	isState := strings.Contains(NewPathInfo(inParams.uri).YangPath, "/single-tagged/state")
+	entry, err := getSubIntfEntry(inParams, isState)
+	if err != nil {
+		log.Info("DbToYang_subintf_vlan_id_xfmr : ", err)
+		return result, nil
+	}
+	if vlan, ok := entry.Field["vlan"]; ok {
+		vlanId, err := strconv.ParseUint(vlan, 10, 16)
+		if err != nil {
+			return result, err
+		}
+		result["vlan-id"] = vlanId
+	}
+	return result, nil
+}
+
+var DbToYang_subintf_get_counters_xfmr SubTreeXfmrDbToYang = func(inParams XfmrParams) error {
+	intfsObj := getIntfsRoot(inParams.ygRoot)
+	pathInfo := NewPathInfo(inParams.uri)
+	uriIfName := pathInfo.Var("name")
+	idx := pathInfo.Var("index")
+
+	targetUriPath := pathInfo.YangPath
+	countersPath := "/openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/state/counters"
+
+	if !isVlanSubIntfIdx(idx) {
+		// Counters of subinterface 0 are the interface counters
+		return nil
+	}
+	i64, _ := strconv.ParseUint(idx, 10, 32)
+	subIdx := uint32(i64)
+
+	var intfObj *ocbinds.OpenconfigInterfaces_Interfaces_Interface
+	if intfsObj != nil && intfsObj.Interface != nil && len(intfsObj.Interface) > 0 {
+		var ok bool = false
+		if intfObj, ok = intfsObj.Interface[uriIfName]; !ok {
+			intfObj, _ = intfsObj.NewInterface(uriIfName)
+		}
+		ygot.BuildEmptyTree(intfObj)
+	} else {
+		ygot.BuildEmptyTree(intfsObj)
+		intfObj, _ = intfsObj.NewInterface(uriIfName)
+		ygot.BuildEmptyTree(intfObj)
+	}
+	if intfObj.Subinterfaces == nil {
+		ygot.BuildEmptyTree(intfObj.Subinterfaces)
+	}
+	subIntfObj, ok := intfObj.Subinterfaces.Subinterface[subIdx]
+	if !ok {
+		subIntfObj, _ = intfObj.Subinterfaces.NewSubinterface(subIdx)
+	}
+	ygot.BuildEmptyTree(subIntfObj)
+	ygot.BuildEmptyTree(subIntfObj.State)
+
+	counter_val := subIntfObj.State.Counters
+	cntPtrs := map[string]**uint64{
+		"in-octets":  &counter_val.InOctets,
+		"in-pkts":    &counter_val.InPkts,
+		"in-errors":  &counter_val.InErrors,
+		"out-octets": &counter_val.OutOctets,
+		"out-pkts":   &counter_val.OutPkts,
+		"out-errors": &counter_val.OutErrors,
+	}
+	return fillRifCounters(inParams.dbs[inParams.curDb], IntfTypeTblMap[IntfTypeVlanSubIntf].CountersHdl, getSubIntfName(uriIfName, idx),
+		strings.TrimPrefix(strings.TrimPrefix(targetUriPath, countersPath), "/"), cntPtrs)
+}
+
+var Subscribe_subintf_get_counters_xfmr SubTreeXfmrSubscribe = func(inParams XfmrSubscInParams) (XfmrSubscOutParams, error) {
+	var err error
+	var result XfmrSubscOutParams
+
+	if inParams.subscProc == TRANSLATE_SUBSCRIBE {
+		pathInfo := NewPathInfo(inParams.uri)
+		log.V(3).Infof("Subscribe_subintf_get_counters_xfmr:- URI:%s pathinfo:%s ", inParams.uri, pathInfo.Path)
+
+		result.nOpts = new(notificationOpts)
+		result.nOpts.pType = Sample
+		result.nOpts.mInterval = 30
+		result.needCache = true
+
+		idx := pathInfo.StringVar("index", "*")
+		if idx == "0" {
+			// Counters of subinterface 0 are the interface counters
+			result.isVirtualTbl = true
+			return result, err
+		}
+		ifName := getSubIntfName(pathInfo.StringVar("name", "*"), idx)
+		oidTblName := IntfTypeTblMap[IntfTypeVlanSubIntf].CountersHdl.OIDTN
+
+		result.dbDataMap = RedisDbSubscribeMap{db.CountersDB: {oidTblName: {"": {FIELD_CURSOR: ifName}}}}
+		log.V(3).Info("Subscribe_subintf_get_counters_xfmr: result ", result)
+	}
+	return result, err
+}
+
 /* Get interface to IP mapping for all interfaces in the given table */
 func getCachedAllIntfIpMap(dbCl *db.DB, tblName string, ipv4 bool, ipv6 bool, ip string, tblPattern *db.Table) (map[string]map[string]db.Value, error) {
 	var err error
@@ -1695,13 +2180,18 @@ func handleAllIntfIPGetForTable(inParams XfmrParams, tblName string, isAppDb boo
 		return nil
 	}
 
-	i32 := uint32(0)
-
 	// YGOT filling
 	for intfName, ipMapDB := range intfIpMap {
 
 		var name string
 		name = *(&intfName)
+		i32 := uint32(0)
+		if strings.Contains(intfName, SUBINTF_SEP) {
+			// IP address of a VLAN tagged subinterface belongs to the subinterface of the parent interface
+			if name, i32, err = splitSubIntfName(intfName); err != nil {
+				continue
+			}
+		}
 
 		if intfsObj != nil && intfsObj.Interface != nil && len(intfsObj.Interface) > 0 {
 			var ok bool = false
@@ -1780,6 +2270,11 @@ func handleIntfIPGetByTargetURI(inParams XfmrParams, targetUriPath string, ifNam
 	pathInfo := NewPathInfo(inParams.uri)
 	ipAddr := pathInfo.Var("ip")
 	i32 := uint32(0)
+	if idx := pathInfo.Var("index"); isVlanSubIntfIdx(idx) {
+		i64, _ := strconv.ParseUint(idx, 10, 32)
+		i32 = uint32(i64)
+		ifName = getSubIntfName(ifName, idx)
+	}
 	intfType, _, ierr := getIntfTypeByName(ifName)
 	if intfType == IntfTypeUnset || ierr != nil {
 		errStr := "Invalid interface type IntfTypeUnset"
@@ -1945,7 +2440,7 @@ var DbToYang_intf_ip_addr_xfmr SubTreeXfmrDbToYang = func(inParams XfmrParams) e
 			return nil
 		}
 
-		intfTypeList := [1]E_InterfaceType{IntfTypeEthernet}
+		intfTypeList := []E_InterfaceType{IntfTypeEthernet, IntfTypeLoopback, IntfTypeVlan, IntfTypeVlanSubIntf}
 
 		// Get IP from all configDb table interfaces
 		for i := 0; i < len(intfTypeList); i++ {
@@ -2009,6 +2504,10 @@ var YangToDb_intf_ip_addr_xfmr SubTreeXfmrYangToDb = func(inParams XfmrParams) (
 	ifName := uriIfName
 
 	sonicIfName := &uriIfName
+	if i32 != 0 {
+		subIfName := getSubIntfName(uriIfName, idx)
+		sonicIfName = &subIfName
+	}
 
 	log.Infof("YangToDb_intf_ip_addr_xfmr: Interface name retrieved from alias : %s is %s", ifName, *sonicIfName)
 	ifName = *sonicIfName
@@ -2611,18 +3110,20 @@ var Subscribe_intf_ip_addr_xfmr = func(inParams XfmrSubscInParams) (XfmrSubscOut
 
 		idx := pathInfo.Var("index")
 		if ifKey != "" {
-			if idx == "0" {
-				intfType, _, _ := getIntfTypeByName(ifKey)
-				intTbl := IntfTypeTblMap[intfType]
-				if targetUriPath == addressStatePath {
-					tableName = intTbl.appDb.intfTN
-				} else {
-					tableName = intTbl.cfgDb.intfTN
-				}
-			} else {
+			intfType, _, _ := getIntfTypeByName(ifKey)
+			if isVlanSubIntfIdx(idx) {
+				ifKey = getSubIntfName(ifKey, idx)
+				intfType = IntfTypeVlanSubIntf
+			} else if idx != "0" {
 				err_str := "Subinterfaces not supported"
 				return result, tlerr.NotSupported(err_str)
 			}
+			intTbl := IntfTypeTblMap[intfType]
+			if targetUriPath == addressStatePath {
+				tableName = intTbl.appDb.intfTN
+			} else {
+				tableName = intTbl.cfgDb.intfTN
+			}
 		}
 
 		ipKey = pathInfo.Var("ip")
@@ -2660,8 +3161,7 @@ var Subscribe_intf_ip_addr_xfmr = func(inParams XfmrSubscInParams) (XfmrSubscOut
 	}
 	result.isVirtualTbl = false
 	result.dbDataMap = make(RedisDbSubscribeMap)
-	uriIfName := pathInfo.Var("name")
-	idx := pathInfo.Var("index")
+	uriIfName := getIntfNameFromUri(pathInfo)
 	sonicIfName := &uriIfName
 	keyName := *sonicIfName
 
@@ -2669,10 +3169,6 @@ var Subscribe_intf_ip_addr_xfmr = func(inParams XfmrSubscInParams) (XfmrSubscOut
 		intfType, _, _ := getIntfTypeByName(keyName)
 		intTbl := IntfTypeTblMap[intfType]
 		tblName := intTbl.cfgDb.intfTN
-		if idx != "" && idx != "0" {
-			err_str := "Subinterfaces not supported"
-			return result, tlerr.NotSupported(err_str)
-		}
 		result.dbDataMap = RedisDbSubscribeMap{db.ConfigDB: {tblName: {keyName: {}}}}
 	}
 	log.Info("Returning Subscribe_intf_ip_addr_xfmr, result:", result)
@@ -2697,7 +3193,9 @@ var DbToYangPath_intf_ip_path_xfmr PathXfmrDbToYangFunc = func(params XfmrDbToYg
 
 	params.ygPathKeys[ifRoot+"/name"] = ifParts[0]
 
-	if params.tblName == "INTERFACE" || params.tblName == "INTF_TABLE" {
+	if params.tblName == "INTERFACE" || params.tblName == "INTF_TABLE" ||
+		params.tblName == "LOOPBACK_INTERFACE" || params.tblName == "VLAN_INTERFACE" ||
+		params.tblName == "VLAN_SUB_INTERFACE" {
 
 		addrPath := "/openconfig-if-ip:ipv4/addresses/address/ip"
 
@@ -2714,8 +3212,7 @@ var DbToYangPath_intf_ip_path_xfmr PathXfmrDbToYangFunc = func(params XfmrDbToYg
 		ipKey := strings.Split(dbKey, "/")
 
 		if len(ifParts) > 1 {
-			err_str := "Subinterfaces not supported"
-			return tlerr.NotSupported(err_str)
+			params.ygPathKeys[subIf+"/index"] = ifParts[1]
 		} else {
 			params.ygPathKeys[subIf+"/index"] = "0"
 		}
@@ -2735,7 +3232,7 @@ var YangToDb_subintf_ipv6_tbl_key_xfmr KeyXfmrYangToDb = func(inParams XfmrParam
 	var err error
 	var inst_key string
 	pathInfo := NewPathInfo(inParams.uri)
-	ifName := pathInfo.Var("name")
+	ifName := getIntfNameFromUri(pathInfo)
 
 	if log.V(3) {
 		log.Info("inParams.requestUri: ", inParams.requestUri)
@@ -2763,7 +3260,7 @@ var YangToDb_ipv6_enabled_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (ma
 	var err error
 	res_map := make(map[string]string)
 	pathInfo := NewPathInfo(inParams.uri)
-	ifUIName := pathInfo.Var("name")
+	ifUIName := getIntfNameFromUri(pathInfo)
 
 	intfType, _, ierr := getIntfTypeByName(ifUIName)
 	if ierr != nil || intfType == IntfTypeUnset {
@@ -0,0 +1,226 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package transformer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/ocbinds"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	log "github.com/golang/glog"
+	"github.com/openconfig/ygot/ygot"
+)
+
+const (
+	/* System tables */
+	DEVICE_METADATA_TBL = "DEVICE_METADATA"
+	NTP_SERVER_TBL      = "NTP_SERVER"
+	PROCESS_STATS_TBL   = "PROCESS_STATS"
+
+	/* System keys */
+	DEVICE_METADATA_KEY   = "localhost"
+	SYS_DOMAIN_NAME_FIELD = "domain_name"
+	NTP_ASSOC_TYPE_FIELD  = "association_type"
+	NTP_IBURST_FIELD      = "iburst"
+
+	/* PROCESS_STATS fields, as written by procdockerstatsd */
+	PROC_CMD_FIELD = "CMD"
+	PROC_CPU_FIELD = "%CPU"
+	PROC_MEM_FIELD = "%MEM"
+)
+
+func init() {
+	XlateFuncBind("YangToDb_sys_domain_name_xfmr", YangToDb_sys_domain_name_xfmr)
+	XlateFuncBind("DbToYang_sys_domain_name_xfmr", DbToYang_sys_domain_name_xfmr)
+	XlateFuncBind("YangToDb_ntp_server_association_type_xfmr", YangToDb_ntp_server_association_type_xfmr)
+	XlateFuncBind("DbToYang_ntp_server_association_type_xfmr", DbToYang_ntp_server_association_type_xfmr)
+	XlateFuncBind("YangToDb_ntp_server_iburst_xfmr", YangToDb_ntp_server_iburst_xfmr)
+	XlateFuncBind("DbToYang_ntp_server_iburst_xfmr", DbToYang_ntp_server_iburst_xfmr)
+	XlateFuncBind("DbToYang_sys_processes_xfmr", DbToYang_sys_processes_xfmr)
+}
+
+var YangToDb_sys_domain_name_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[SYS_DOMAIN_NAME_FIELD] = ""
+		return res_map, nil
+	}
+	domainName, _ := inParams.param.(*string)
+	if domainName != nil {
+		res_map[SYS_DOMAIN_NAME_FIELD] = *domainName
+	}
+	return res_map, nil
+}
+
+var DbToYang_sys_domain_name_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	entry, ok := data[DEVICE_METADATA_TBL][DEVICE_METADATA_KEY]
+	if !ok {
+		log.V(3).Info("DbToYang_sys_domain_name_xfmr: DEVICE_METADATA entry not found")
+		return result, nil
+	}
+	if domainName, ok := entry.Field[SYS_DOMAIN_NAME_FIELD]; ok {
+		result["domain-name"] = domainName
+	}
+	return result, nil
+}
+
+var YangToDb_ntp_server_association_type_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[NTP_ASSOC_TYPE_FIELD] = ""
+		return res_map, nil
+	}
+	assocType, ok := inParams.param.(ygot.GoEnum)
+	if !ok {
+		return res_map, nil
+	}
+	assocName, err := ygot.EnumName(assocType)
+	if err != nil {
+		return res_map, err
+	}
+	switch assocName {
+	case "SERVER", "POOL":
+		res_map[NTP_ASSOC_TYPE_FIELD] = strings.ToLower(assocName)
+	case "":
+		return res_map, nil
+	default:
+		return res_map, tlerr.NotSupported("NTP association type %s not supported", assocName)
+	}
+	return res_map, nil
+}
+
+var DbToYang_ntp_server_association_type_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	entry, ok := data[NTP_SERVER_TBL][inParams.key]
+	if !ok {
+		log.V(3).Info("DbToYang_ntp_server_association_type_xfmr: NTP server not found : ", inParams.key)
+		return result, nil
+	}
+	if assocType, ok := entry.Field[NTP_ASSOC_TYPE_FIELD]; ok && len(assocType) > 0 {
+		result["association-type"] = strings.ToUpper(assocType)
+	}
+	return result, nil
+}
+
+var YangToDb_ntp_server_iburst_xfmr FieldXfmrYangToDb = func(inParams XfmrParams) (map[string]string, error) {
+	res_map := make(map[string]string)
+	if inParams.param == nil {
+		res_map[NTP_IBURST_FIELD] = ""
+		return res_map, nil
+	}
+	iburst, _ := inParams.param.(*bool)
+	if iburst == nil {
+		return res_map, nil
+	}
+	if *iburst {
+		res_map[NTP_IBURST_FIELD] = "on"
+	} else {
+		res_map[NTP_IBURST_FIELD] = "off"
+	}
+	return res_map, nil
+}
+
+var DbToYang_ntp_server_iburst_xfmr FieldXfmrDbtoYang = func(inParams XfmrParams) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	data := (*inParams.dbDataMap)[inParams.curDb]
+	entry, ok := data[NTP_SERVER_TBL][inParams.key]
+	if !ok {
+		log.V(3).Info("DbToYang_ntp_server_iburst_xfmr: NTP server not found : ", inParams.key)
+		return result, nil
+	}
+	if iburst, ok := entry.Field[NTP_IBURST_FIELD]; ok {
+		result["iburst"] = (iburst == "on")
+	}
+	return result, nil
+}
+
+func fillSysProcessInfo(proc *ocbinds.OpenconfigSystem_System_Processes_Process, pid uint64, entry db.Value) {
+	ygot.BuildEmptyTree(proc)
+
+	name := entry.Get(PROC_CMD_FIELD)
+	f, _ := strconv.ParseFloat(entry.Get(PROC_CPU_FIELD), 32)
+	cpuUtil := uint8(f)
+	f, _ = strconv.ParseFloat(entry.Get(PROC_MEM_FIELD), 32)
+	memUtil := uint8(f)
+	var zero uint64
+
+	proc.Pid = &pid
+	proc.State.Pid = &pid
+	proc.State.Name = &name
+	proc.State.CpuUtilization = &cpuUtil
+	proc.State.MemoryUtilization = &memUtil
+	/* Not reported by procdockerstatsd */
+	proc.State.CpuUsageUser = &zero
+	proc.State.CpuUsageSystem = &zero
+	proc.State.MemoryUsage = &zero
+	proc.State.StartTime = &zero
+	proc.State.Uptime = &zero
+}
+
+var DbToYang_sys_processes_xfmr SubTreeXfmrDbToYang = func(inParams XfmrParams) error {
+	pathInfo := NewPathInfo(inParams.uri)
+	pidStr := pathInfo.Var("pid")
+	log.V(3).Info("DbToYang_sys_processes_xfmr: ", inParams.uri)
+
+	deviceObj := (*inParams.ygRoot).(*ocbinds.Device)
+	if deviceObj.System == nil {
+		ygot.BuildEmptyTree(deviceObj)
+	}
+	if deviceObj.System.Processes == nil {
+		ygot.BuildEmptyTree(deviceObj.System)
+	}
+	procsObj := deviceObj.System.Processes
+
+	d := inParams.dbs[db.StateDB]
+	ts := &db.TableSpec{Name: PROCESS_STATS_TBL}
+	var keys []db.Key
+	if pidStr != "" {
+		keys = []db.Key{{Comp: []string{pidStr}}}
+	} else {
+		keys, _ = d.GetKeys(ts)
+	}
+
+	for _, key := range keys {
+		pid, err := strconv.ParseUint(key.Get(0), 10, 64)
+		if err != nil {
+			log.Warningf("DbToYang_sys_processes_xfmr: invalid pid %v", key.Get(0))
+			continue
+		}
+		entry, err := d.GetEntry(ts, key)
+		if err != nil {
+			if pidStr != "" {
+				return tlerr.NotFound("Resource not found")
+			}
+			continue
+		}
+		proc, ok := procsObj.Process[pid]
+		if !ok {
+			proc, _ = procsObj.NewProcess(pid)
+		}
+		fillSysProcessInfo(proc, pid, entry)
+	}
+
+	return nil
+}
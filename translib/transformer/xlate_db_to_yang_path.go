@@ -0,0 +1,161 @@
+////////////////////////////////////////////////////////////////////////////////
+//                                                                            //
+//  Copyright 2024 Broadcom. The term Broadcom refers to Broadcom Inc. and/or //
+//  its subsidiaries.                                                         //
+//                                                                            //
+//  Licensed under the Apache License, Version 2.0 (the "License");           //
+//  you may not use this file except in compliance with the License.          //
+//  You may obtain a copy of the License at                                   //
+//                                                                            //
+//     http://www.apache.org/licenses/LICENSE-2.0                             //
+//                                                                            //
+//  Unless required by applicable law or agreed to in writing, software       //
+//  distributed under the License is distributed on an "AS IS" BASIS,         //
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.  //
+//  See the License for the specific language governing permissions and       //
+//  limitations under the License.                                            //
+//                                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+package transformer
+
+import (
+	"strings"
+
+	"github.com/Azure/sonic-mgmt-common/translib/db"
+	"github.com/Azure/sonic-mgmt-common/translib/path"
+	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
+	log "github.com/golang/glog"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// XlateDbEntryToYangPaths returns the OpenConfig YANG paths mapped to the
+// CONFIG_DB table entry. When fields are given, the paths of the leaves
+// mapped to those fields are returned instead of the list instance paths.
+// The translation is best effort; the paths whose keys can not be resolved
+// (for eg, the key transformer needs an entry which is deleted) are skipped.
+func XlateDbEntryToYangPaths(table string, key db.Key, entry *db.Value,
+	fields []string, dbs [db.MaxDB]*db.DB) []string {
+
+	tblInfo, ok := xDbSpecMap[table]
+	if !ok || tblInfo == nil {
+		return nil
+	}
+
+	var paths []string
+	for _, xpath := range tblInfo.yangXpath {
+		if strings.HasPrefix(xpath, "/sonic-") {
+			continue
+		}
+		listPath, err := xlateDbKeyToYangListPath(xpath, table, key, entry, dbs)
+		if err != nil || listPath == nil {
+			continue
+		}
+		if len(fields) == 0 {
+			paths = append(paths, path.String(listPath))
+			continue
+		}
+		for _, field := range fields {
+			for _, leafXpath := range dbFieldYangXpaths(table, field, xpath) {
+				paths = append(paths, path.String(listPath)+leafXpath)
+			}
+		}
+	}
+
+	return paths
+}
+
+// XlateDbEntryToSonicYangPaths returns the sonic YANG path of the table
+// entry, or of the entry fields when given.
+func XlateDbEntryToSonicYangPaths(table string, key db.Key, fields []string) []string {
+	tblInfo, ok := xDbSpecMap[table]
+	if !ok || tblInfo == nil || len(tblInfo.module) == 0 {
+		return nil
+	}
+
+	for _, listName := range tblInfo.listName {
+		listInfo, ok := xDbSpecMap[table+"/"+listName]
+		if !ok || listInfo == nil || len(listInfo.keyList) != key.Len() {
+			continue
+		}
+		elem := &gnmi.PathElem{Name: listName}
+		for i, kn := range listInfo.keyList {
+			path.SetKey(elem, kn, key.Get(i))
+		}
+		listPath := path.String(&gnmi.Path{Elem: []*gnmi.PathElem{
+			{Name: tblInfo.module + ":" + tblInfo.module}, {Name: table}, elem}})
+
+		if len(fields) == 0 {
+			return []string{listPath}
+		}
+		paths := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if field == "NULL" {
+				continue
+			}
+			paths = append(paths, listPath+"/"+strings.TrimSuffix(field, "@"))
+		}
+		return paths
+	}
+
+	return nil
+}
+
+// xlateDbKeyToYangListPath resolves the keys of the list xpath from the db key.
+func xlateDbKeyToYangListPath(xpath, table string, key db.Key, entry *db.Value,
+	dbs [db.MaxDB]*db.DB) (*gnmi.Path, error) {
+
+	xpathInfo, ok := xYangSpecMap[xpath]
+	if !ok || xpathInfo == nil || xpathInfo.yangEntry == nil ||
+		!xpathInfo.yangEntry.IsList() {
+		return nil, nil
+	}
+
+	gPath := &gnmi.Path{}
+	ygPath := ""
+	for idx, name := range strings.Split(strings.TrimPrefix(xpath, "/"), "/") {
+		ygPath += "/" + name
+		if idx > 0 {
+			name = name[strings.Index(name, ":")+1:]
+		}
+		elem := &gnmi.PathElem{Name: name}
+		if info, ok := xYangSpecMap[ygPath]; ok && info != nil &&
+			info.yangEntry != nil && info.yangEntry.IsList() {
+			for _, kn := range strings.Fields(info.yangEntry.Key) {
+				path.SetKey(elem, kn, "*")
+			}
+		}
+		gPath.Elem = append(gPath.Elem, elem)
+	}
+
+	respXlator, err := NewSubscribeNotfRespXlator("cfgdiff", gPath, db.ConfigDB,
+		&db.TableSpec{Name: table}, &key, entry, dbs, nil)
+	if err == nil {
+		gPath, err = respXlator.Translate()
+	}
+	if err == nil && path.HasWildcardKey(gPath) {
+		err = tlerr.NotSupported("keys not resolved for %s", xpath)
+	}
+	if err != nil {
+		log.Infof("xlateDbKeyToYangListPath: %s %v: xpath %s: err %v",
+			table, key, xpath, err)
+		return nil, err
+	}
+	return gPath, nil
+}
+
+// dbFieldYangXpaths returns the xpaths of the leaves mapped to the table field,
+// relative to the list xpath.
+func dbFieldYangXpaths(table, field, listXpath string) []string {
+	fldInfo, ok := xDbSpecMap[table+"/"+strings.TrimSuffix(field, "@")]
+	if !ok || fldInfo == nil {
+		return nil
+	}
+	var xpaths []string
+	for _, xpath := range fldInfo.yangXpath {
+		if strings.HasPrefix(xpath, listXpath+"/") {
+			xpaths = append(xpaths, strings.TrimPrefix(xpath, listXpath))
+		}
+	}
+	return xpaths
+}
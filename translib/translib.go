@@ -35,8 +35,8 @@ package translib
 
 import (
 	"context"
-	"sync"
 
+	"github.com/Azure/sonic-mgmt-common/translib/cs"
 	"github.com/Azure/sonic-mgmt-common/translib/db"
 	"github.com/Azure/sonic-mgmt-common/translib/tlerr"
 	"github.com/Workiva/go-datastructures/queue"
@@ -44,8 +44,9 @@ import (
 	"github.com/openconfig/ygot/ygot"
 )
 
-// Write lock for all write operations to be synchronized
-var writeMutex = &sync.Mutex{}
+// Write lock for all write operations to be synchronized. It also guards
+// the candidate config DBs of the config sessions, hence is shared with cs.
+var writeMutex = cs.RequestMutex()
 
 type ErrSource int
 
@@ -73,11 +74,20 @@ type SetRequest struct {
 	AuthEnabled      bool
 	ClientVersion    Version
 	DeleteEmptyEntry bool
+	// MaxValidationErrors is the maximum number of CVL validation errors
+	// to report. By default, the request fails at the first one.
+	MaxValidationErrors int
+	// Session is the config session to which the changes are written.
+	// Changes are written to the running config if it is empty.
+	Session ConfigSession
 }
 
 type SetResponse struct {
 	ErrSrc ErrSource
 	Err    error
+	// Errors lists the individual errors, one per CVL validation failure,
+	// when more than one is reported.
+	Errors []error
 }
 
 type QueryParameters struct {
@@ -94,6 +104,9 @@ type GetRequest struct {
 	ClientVersion Version
 	QueryParams   QueryParameters
 	Ctxt          context.Context
+	// Session is the config session whose candidate config is read.
+	// Running config is read if it is empty.
+	Session ConfigSession
 }
 
 type GetResponse struct {
@@ -123,6 +136,12 @@ type BulkRequest struct {
 	User           UserRoles
 	AuthEnabled    bool
 	ClientVersion  Version
+	// MaxValidationErrors is the maximum number of CVL validation errors
+	// to report for the whole bulk request; see SetRequest.
+	MaxValidationErrors int
+	// Session is the config session to which the changes are written;
+	// see SetRequest.
+	Session ConfigSession
 }
 
 type BulkResponse struct {
@@ -176,14 +195,22 @@ func Create(req SetRequest) (SetResponse, error) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
-	d, err := db.NewDB(getDBOptions(db.ConfigDB))
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
 
 	if err != nil {
 		resp.ErrSrc = ProtoErr
 		return resp, err
 	}
 
-	defer d.DeleteDB()
+	dbOpts := getDBOptions(db.ConfigDB, withCVLMaxErrors(req.MaxValidationErrors))
+	d, _, cleanup, err := sess.GetConfigDB(&dbOpts)
+
+	if err != nil {
+		resp.ErrSrc = ProtoErr
+		return resp, err
+	}
+
+	defer cleanup()
 
 	keys, err = (*app).translateCreate(d)
 
@@ -204,6 +231,7 @@ func Create(req SetRequest) (SetResponse, error) {
 	if err != nil {
 		d.AbortTx()
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 		return resp, err
 	}
 
@@ -211,6 +239,7 @@ func Create(req SetRequest) (SetResponse, error) {
 
 	if err != nil {
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 	}
 
 	return resp, err
@@ -249,14 +278,22 @@ func Update(req SetRequest) (SetResponse, error) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
-	d, err := db.NewDB(getDBOptions(db.ConfigDB))
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
+
+	if err != nil {
+		resp.ErrSrc = ProtoErr
+		return resp, err
+	}
+
+	dbOpts := getDBOptions(db.ConfigDB, withCVLMaxErrors(req.MaxValidationErrors))
+	d, _, cleanup, err := sess.GetConfigDB(&dbOpts)
 
 	if err != nil {
 		resp.ErrSrc = ProtoErr
 		return resp, err
 	}
 
-	defer d.DeleteDB()
+	defer cleanup()
 
 	keys, err = (*app).translateUpdate(d)
 
@@ -277,6 +314,7 @@ func Update(req SetRequest) (SetResponse, error) {
 	if err != nil {
 		d.AbortTx()
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 		return resp, err
 	}
 
@@ -284,6 +322,7 @@ func Update(req SetRequest) (SetResponse, error) {
 
 	if err != nil {
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 	}
 
 	return resp, err
@@ -323,14 +362,22 @@ func Replace(req SetRequest) (SetResponse, error) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
-	d, err := db.NewDB(getDBOptions(db.ConfigDB))
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
 
 	if err != nil {
 		resp.ErrSrc = ProtoErr
 		return resp, err
 	}
 
-	defer d.DeleteDB()
+	dbOpts := getDBOptions(db.ConfigDB, withCVLMaxErrors(req.MaxValidationErrors))
+	d, _, cleanup, err := sess.GetConfigDB(&dbOpts)
+
+	if err != nil {
+		resp.ErrSrc = ProtoErr
+		return resp, err
+	}
+
+	defer cleanup()
 
 	keys, err = (*app).translateReplace(d)
 
@@ -351,6 +398,7 @@ func Replace(req SetRequest) (SetResponse, error) {
 	if err != nil {
 		d.AbortTx()
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 		return resp, err
 	}
 
@@ -358,6 +406,7 @@ func Replace(req SetRequest) (SetResponse, error) {
 
 	if err != nil {
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 	}
 
 	return resp, err
@@ -396,14 +445,22 @@ func Delete(req SetRequest) (SetResponse, error) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
-	d, err := db.NewDB(getDBOptions(db.ConfigDB))
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
+
+	if err != nil {
+		resp.ErrSrc = ProtoErr
+		return resp, err
+	}
+
+	dbOpts := getDBOptions(db.ConfigDB, withCVLMaxErrors(req.MaxValidationErrors))
+	d, _, cleanup, err := sess.GetConfigDB(&dbOpts)
 
 	if err != nil {
 		resp.ErrSrc = ProtoErr
 		return resp, err
 	}
 
-	defer d.DeleteDB()
+	defer cleanup()
 
 	keys, err = (*app).translateDelete(d)
 
@@ -424,6 +481,7 @@ func Delete(req SetRequest) (SetResponse, error) {
 	if err != nil {
 		d.AbortTx()
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 		return resp, err
 	}
 
@@ -431,6 +489,7 @@ func Delete(req SetRequest) (SetResponse, error) {
 
 	if err != nil {
 		resp.ErrSrc = AppErr
+		resp.Errors = errorEntries(err)
 	}
 
 	return resp, err
@@ -465,14 +524,27 @@ func Get(req GetRequest) (GetResponse, error) {
 		return resp, err
 	}
 
-	dbs, err := getAllDbs(withWriteDisable)
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
 
 	if err != nil {
 		resp = GetResponse{Payload: payload, ErrSrc: ProtoErr}
 		return resp, err
 	}
 
-	defer closeAllDbs(dbs[:])
+	if sess.IsConfigSession() {
+		// Candidate config DB is shared with the writes of the session
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+	}
+
+	dbs, closeDbs, err := getAllDbsForSession(&sess, withWriteDisable)
+
+	if err != nil {
+		resp = GetResponse{Payload: payload, ErrSrc: ProtoErr}
+		return resp, err
+	}
+
+	defer closeDbs()
 
 	err = (*app).translateGet(dbs)
 
@@ -566,13 +638,20 @@ func Bulk(req BulkRequest) (BulkResponse, error) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
-	d, err := db.NewDB(getDBOptions(db.ConfigDB))
+	sess, err := getConfigSession(req.Session, req.User, req.AuthEnabled)
 
 	if err != nil {
 		return resp, err
 	}
 
-	defer d.DeleteDB()
+	dbOpts := getDBOptions(db.ConfigDB, withCVLMaxErrors(req.MaxValidationErrors))
+	d, _, cleanup, err := sess.GetConfigDB(&dbOpts)
+
+	if err != nil {
+		return resp, err
+	}
+
+	defer cleanup()
 
 	//Start the transaction without any keys or tables to watch will be added later using AppendWatchTx
 	err = d.StartTx(nil, nil)
@@ -627,6 +706,7 @@ func Bulk(req BulkRequest) (BulkResponse, error) {
 			d.AbortTx()
 			resp.DeleteResponse[i].ErrSrc = errSrc
 			resp.DeleteResponse[i].Err = err
+			resp.DeleteResponse[i].Errors = errorEntries(err)
 			return resp, err
 		}
 	}
@@ -680,6 +760,7 @@ func Bulk(req BulkRequest) (BulkResponse, error) {
 			d.AbortTx()
 			resp.ReplaceResponse[i].ErrSrc = errSrc
 			resp.ReplaceResponse[i].Err = err
+			resp.ReplaceResponse[i].Errors = errorEntries(err)
 			return resp, err
 		}
 	}
@@ -730,6 +811,7 @@ func Bulk(req BulkRequest) (BulkResponse, error) {
 			d.AbortTx()
 			resp.UpdateResponse[i].ErrSrc = errSrc
 			resp.UpdateResponse[i].Err = err
+			resp.UpdateResponse[i].Errors = errorEntries(err)
 			return resp, err
 		}
 	}
@@ -780,6 +862,7 @@ func Bulk(req BulkRequest) (BulkResponse, error) {
 			d.AbortTx()
 			resp.CreateResponse[i].ErrSrc = errSrc
 			resp.CreateResponse[i].Err = err
+			resp.CreateResponse[i].Errors = errorEntries(err)
 			return resp, err
 		}
 	}
@@ -851,6 +934,28 @@ func withOnChange(o *db.Options) {
 	o.IsOnChangeEnabled = true
 }
 
+func withCVLMaxErrors(maxErrors int) func(*db.Options) {
+	return func(o *db.Options) {
+		o.CVLMaxErrors = maxErrors
+	}
+}
+
+// errorEntries splits err into one error per CVL validation failure,
+// if it reports more than one. Returns nil otherwise.
+func errorEntries(err error) []error {
+	cvlErr, ok := err.(tlerr.TranslibCVLFailure)
+	if !ok || len(cvlErr.Errors) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(cvlErr.Errors))
+	for _, cei := range cvlErr.Errors {
+		errs = append(errs, tlerr.TranslibCVLFailure{
+			Code: int(cei.ErrCode), CVLErrorInfo: cei})
+	}
+	return errs
+}
+
 func getAppModule(path string, clientVer Version) (*appInterface, *appInfo, error) {
 	var app appInterface
 